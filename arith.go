@@ -0,0 +1,142 @@
+package durafmt
+
+import (
+	"math"
+	"time"
+)
+
+// Add returns a new Durafmt holding d+t, with the same output format as d.
+// The sum saturates at the extremes of time.Duration instead of overflowing.
+func (d *Durafmt) Add(t time.Duration) *Durafmt {
+	return d.withDuration(add(d.Duration(), t))
+}
+
+// Sub returns a new Durafmt holding d-t, with the same output format as d.
+// The difference saturates at the extremes of time.Duration instead of overflowing.
+func (d *Durafmt) Sub(t time.Duration) *Durafmt {
+	return d.withDuration(sub(d.Duration(), t))
+}
+
+// Mul returns a new Durafmt holding d*n, with the same output format as d.
+// The product saturates at the extremes of time.Duration instead of overflowing.
+func (d *Durafmt) Mul(n int64) *Durafmt {
+	return d.withDuration(mul(d.Duration(), n))
+}
+
+// Scale returns a new Durafmt holding d*f rounded to the nanosecond, with the same output format as d,
+// e.g. Scale(0.3) for a warning at 30% of a timeout. The product saturates at the extremes
+// of time.Duration instead of overflowing, NaN gives zero.
+func (d *Durafmt) Scale(f float64) *Durafmt {
+	return d.withDuration(scale(d.Duration(), f))
+}
+
+// Div returns a new Durafmt holding d/n, with the same output format as d.
+// Like integer division it panics if n is zero.
+func (d *Durafmt) Div(n int64) *Durafmt {
+	if d.Duration() == math.MinInt64 && n == -1 {
+		return d.withDuration(math.MaxInt64)
+	}
+	return d.withDuration(d.Duration() / time.Duration(n))
+}
+
+// IsZero reports whether the duration is zero, a nil d included.
+func (d *Durafmt) IsZero() bool {
+	return d.Duration() == 0
+}
+
+// IsNegative reports whether the duration is shorter than zero.
+func (d *Durafmt) IsNegative() bool {
+	return d.Duration() < 0
+}
+
+// Abs returns a new Durafmt holding the absolute value of d, with the same output format as d.
+// math.MinInt64 saturates to math.MaxInt64.
+func (d *Durafmt) Abs() *Durafmt {
+	return d.withDuration(abs(d.Duration()))
+}
+
+// Normalize returns a new Durafmt holding the same duration as d in its canonical form,
+// as if created with Parse, with the same output format as d. Inputs such as "-0s" or
+// "90m" of ParseString become "0s" and "1h30m0s", and calendar years of Between fixed years.
+func (d *Durafmt) Normalize() *Durafmt {
+	return d.withDuration(d.Duration())
+}
+
+// withDuration returns a copy of d holding another duration.
+func (d *Durafmt) withDuration(duration time.Duration) *Durafmt {
+	c := d.Clone()
+	c.duration = duration
+	c.input = duration.String()
+	c.calendar, c.years, c.yearsSpan = false, 0, 0
+	return c
+}
+
+// abs returns the absolute value of duration, math.MinInt64 saturates to math.MaxInt64.
+func abs(duration time.Duration) time.Duration {
+	switch {
+	case duration == math.MinInt64:
+		return math.MaxInt64
+	case duration < 0:
+		return -duration
+	}
+	return duration
+}
+
+// add returns a+b, saturating at the extremes of time.Duration.
+func add(a, b time.Duration) time.Duration {
+	sum := a + b
+	switch {
+	case a > 0 && b > 0 && sum < 0:
+		return math.MaxInt64
+	case a < 0 && b < 0 && sum >= 0:
+		return math.MinInt64
+	}
+	return sum
+}
+
+// sub returns a-b, saturating at the extremes of time.Duration.
+func sub(a, b time.Duration) time.Duration {
+	diff := a - b
+	switch {
+	case a >= 0 && b < 0 && diff < 0:
+		return math.MaxInt64
+	case a < 0 && b > 0 && diff >= 0:
+		return math.MinInt64
+	}
+	return diff
+}
+
+// scale returns a*f rounded to the nanosecond, saturating at the extremes of time.Duration.
+func scale(a time.Duration, f float64) time.Duration {
+	return saturate(float64(a) * f)
+}
+
+// saturate returns the nanoseconds ns rounded to a time.Duration, saturating at its extremes.
+// NaN is a zero duration.
+func saturate(ns float64) time.Duration {
+	ns = math.Round(ns)
+	switch {
+	case math.IsNaN(ns):
+		return 0
+	case ns >= math.MaxInt64:
+		return math.MaxInt64
+	case ns <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(ns)
+}
+
+// mul returns a*n, saturating at the extremes of time.Duration.
+func mul(a time.Duration, n int64) time.Duration {
+	if a == 0 || n == 0 {
+		return 0
+	}
+	product := a * time.Duration(n)
+	if product/time.Duration(n) != a || (n == -1 && a == math.MinInt64) {
+		if (a < 0) != (n < 0) {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return product
+}
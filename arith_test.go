@@ -0,0 +1,99 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestArithmetic(t *testing.T) {
+	base := Parse(time.Hour).WithLocale(English)
+
+	testDurafmts := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{base.Add(30 * time.Minute), "1 hour 30 minutes"},
+		{base.Sub(90 * time.Minute), "-30 minutes"},
+		{base.Mul(3), "3 hours"},
+		{base.Div(4), "15 minutes"},
+		{base.Scale(0.3), "18 minutes"},
+		{base.Scale(-1.5), "-1 hour 30 minutes"},
+		{base.LimitFirstN(1).Scale(2.75), "2 hours"},
+		{base.Scale(1e300), Parse(math.MaxInt64).WithLocale(English).String()},
+		{base.Scale(math.Inf(-1)), Parse(math.MinInt64).WithLocale(English).String()},
+		{base.Scale(math.NaN()), ""},
+		{base.LimitToUnit(MinutesKey).Mul(2), "120 minutes"},
+		{base, "1 hour"},
+	}
+
+	for _, table := range testDurafmts {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	negativeZero, _ := ParseString("-0s")
+	var none *Durafmt
+
+	testPredicates := []struct {
+		d                  *Durafmt
+		isZero, isNegative bool
+		abs, normalized    string
+	}{
+		{Parse(90 * time.Minute), false, false, "1 ч. 30 мин.", "1 ч. 30 мин."},
+		{Parse(-90 * time.Minute), false, true, "1 ч. 30 мин.", "-1 ч. 30 мин."},
+		{Parse(0), true, false, "", ""},
+		{negativeZero, true, false, "", ""},
+		{Parse(math.MinInt64).LimitFirstN(1), false, true, "292 года", "-292 года"},
+		{none, true, false, "", ""},
+	}
+
+	for _, table := range testPredicates {
+		if result := table.d.IsZero(); result != table.isZero {
+			t.Errorf("IsZero(%s) got %v, expected %v", table.d.Duration(), result, table.isZero)
+		}
+		if result := table.d.IsNegative(); result != table.isNegative {
+			t.Errorf("IsNegative(%s) got %v, expected %v", table.d.Duration(), result, table.isNegative)
+		}
+		if result := table.d.Abs().String(); result != table.abs {
+			t.Errorf("Abs(%s) got %q, expected %q", table.d.Duration(), result, table.abs)
+		}
+		if result := table.d.Normalize().String(); result != table.normalized {
+			t.Errorf("Normalize(%s) got %q, expected %q", table.d.Duration(), result, table.normalized)
+		}
+	}
+
+	if result := negativeZero.String(); result == negativeZero.Normalize().String() {
+		t.Errorf("Normalize(-0s) got %q, expected the sign dropped", result)
+	}
+}
+
+func TestExtremes(t *testing.T) {
+	extreme := "292 years 24 weeks 3 days 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds"
+	max := Parse(math.MaxInt64).WithLocale(English)
+	min := Parse(math.MinInt64).WithLocale(English)
+
+	testDurafmts := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{max, extreme},
+		{min, "-" + extreme},
+		{min.WithNanoseconds(), "-" + extreme + " 808 nanoseconds"},
+		{max.Add(time.Hour), extreme},
+		{min.Sub(time.Hour), "-" + extreme},
+		{max.Mul(-2), "-" + extreme},
+		{min.Mul(-1), extreme},
+		{min.Div(-1), extreme},
+		{max.Ceil().LimitFirstN(1), "292 years"},
+	}
+
+	for _, table := range testDurafmts {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package durafmt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/ihippik/durafmt/internal/plural"
+)
+
+//go:generate go run ./cmd/durafmt-localegen -o locales_gen.go locales/uk.json
+
+var (
+	bundlesMu     sync.RWMutex
+	bundleLocales = make(map[string]Locale)
+)
+
+// Bundle is the data of a locale in a serializable form, e.g. a JSON file, loaded at run time
+// with Locale or compiled into Go source by cmd/durafmt-localegen.
+type Bundle struct {
+	// Name is the BCP 47 tag of the locale, e.g. "uk".
+	Name string `json:"name"`
+	// Parent is the name of the locale the bundle extends, see Locale.
+	Parent string `json:"parent,omitempty"`
+	// Title is the English name of the locale, e.g. "Ukrainian", naming the generated variable.
+	Title string `json:"title,omitempty"`
+	// Units and Compact hold the unit abbreviations, ordered from years to nanoseconds,
+	// SingleUnits the phrases of exactly one unit, see Locale.
+	Units       []string `json:"units"`
+	Compact     []string `json:"compact"`
+	SingleUnits []string `json:"singleUnits,omitempty"`
+	// UnitPlurals holds the forms of Units by unit name and category name, e.g. {"hours": {"one": "hr"}}.
+	// Bundles with their own Units don't inherit the forms of the parent.
+	UnitPlurals map[string]map[string]string `json:"unitPlurals,omitempty"`
+
+	GroupSeparator   string `json:"groupSeparator,omitempty"`
+	DecimalSeparator string `json:"decimalSeparator,omitempty"`
+	Digits           string `json:"digits,omitempty"`
+	ListSeparator    string `json:"listSeparator,omitempty"`
+	ListAnd          string `json:"listAnd,omitempty"`
+	Respectively     string `json:"respectively,omitempty"`
+	Remaining        string `json:"remaining,omitempty"`
+	Overdue          string `json:"overdue,omitempty"`
+	MoreThan         string `json:"moreThan,omitempty"`
+	LessThan         string `json:"lessThan,omitempty"`
+	Segments         string `json:"segments,omitempty"`
+	SegmentsRest     string `json:"segmentsRest,omitempty"`
+
+	// Plural holds the CLDR plural rules by category name, "zero", "one", "two", "few" or "many",
+	// e.g. {"one": "n % 10 = 1 and n % 100 != 11"}. Numbers matching no rule are "other".
+	Plural map[string]string `json:"plural,omitempty"`
+	// Words holds the unit nouns by unit name and category name, e.g. {"hours": {"one": "година"}}.
+	// Every unit needs the "other" form and the forms of the categories of Plural.
+	Words map[string]map[string]string `json:"words,omitempty"`
+	// Interval holds the noun of a segment of time by category name.
+	Interval map[string]string `json:"interval,omitempty"`
+	// Layout is how the displayed units are arranged, nil for the layout of the parent.
+	Layout *Layout `json:"layout,omitempty"`
+	// Docker holds the phrases of the Docker style.
+	Docker DockerPhrases `json:"docker,omitempty"`
+}
+
+// ReadBundle decodes a JSON bundle from r, rejecting unknown fields.
+func ReadBundle(r io.Reader) (Bundle, error) {
+	var b Bundle
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&b); err != nil {
+		return Bundle{}, fmt.Errorf("durafmt: bundle: %v", err)
+	}
+	return b, nil
+}
+
+// Locale validates the bundle and returns the locale it holds. A bundle extends its parent locale,
+// named by Parent or by the tag of Name without its last subtag, e.g. "ru" for "ru-RU":
+// the fields and the unit forms missing from the bundle are those of the parent,
+// so a regional bundle only holds what differs.
+func (b Bundle) Locale() (Locale, error) {
+	return b.locale(LookupLocale)
+}
+
+// locale returns the locale of the bundle, finding its parent with lookup.
+func (b Bundle) locale(lookup func(name string) (Locale, bool)) (Locale, error) {
+	if b.Name == "" {
+		return Locale{}, errors.New("durafmt: bundle: no name")
+	}
+	fail := func(format string, a ...interface{}) (Locale, error) {
+		return Locale{}, fmt.Errorf("durafmt: bundle %s: "+format, append([]interface{}{b.Name}, a...)...)
+	}
+
+	var l Locale
+	if b.Parent != "" {
+		parent, ok := lookup(b.Parent)
+		if !ok {
+			return fail("unknown parent %q", b.Parent)
+		}
+		l = parent
+	} else if tag := parentTag(b.Name); tag != "" {
+		l, _ = lookup(tag)
+	}
+	l.Name = b.Name
+	for _, field := range []struct {
+		dst *[]string
+		src []string
+	}{
+		{&l.Units, b.Units},
+		{&l.Compact, b.Compact},
+		{&l.SingleUnits, b.SingleUnits},
+	} {
+		if field.src != nil {
+			*field.dst = field.src
+		}
+	}
+	for _, field := range []struct {
+		dst *string
+		src string
+	}{
+		{&l.GroupSeparator, b.GroupSeparator},
+		{&l.DecimalSeparator, b.DecimalSeparator},
+		{&l.Digits, b.Digits},
+		{&l.ListSeparator, b.ListSeparator},
+		{&l.ListAnd, b.ListAnd},
+		{&l.Respectively, b.Respectively},
+		{&l.Remaining, b.Remaining},
+		{&l.Overdue, b.Overdue},
+		{&l.MoreThan, b.MoreThan},
+		{&l.LessThan, b.LessThan},
+		{&l.Segments, b.Segments},
+		{&l.SegmentsRest, b.SegmentsRest},
+		{&l.Docker.LessThanASecond, b.Docker.LessThanASecond},
+		{&l.Docker.AboutAMinute, b.Docker.AboutAMinute},
+		{&l.Docker.AboutAnHour, b.Docker.AboutAnHour},
+		{&l.Docker.Months, b.Docker.Months},
+		{&l.Docker.Ago, b.Docker.Ago},
+	} {
+		if field.src != "" {
+			*field.dst = field.src
+		}
+	}
+
+	if b.Layout != nil {
+		l.Layout = *b.Layout
+	}
+	if b.Units != nil {
+		l.UnitPlurals = nil
+	}
+
+	n := int(Nanoseconds) + 1
+	if len(l.Units) != n || len(l.Compact) != n || (l.SingleUnits != nil && len(l.SingleUnits) != n) {
+		return fail("units, compact and singleUnits need %d entries", n)
+	}
+
+	categories, rules, err := b.pluralRules()
+	if err != nil {
+		return fail("%v", err)
+	}
+	if len(rules) > 0 {
+		l.Plural = func(n int64) PluralCategory {
+			for i, rule := range rules {
+				if rule.Match(n) {
+					return categories[i]
+				}
+			}
+			return PluralOther
+		}
+	}
+	if l.Words, err = b.words(l.Words, categories); err != nil {
+		return fail("%v", err)
+	}
+	if l.UnitPlurals, err = mergeUnitForms(l.UnitPlurals, b.UnitPlurals); err != nil {
+		return fail("unitPlurals %v", err)
+	}
+	if l.Interval, err = mergeForms(l.Interval, b.Interval); err != nil {
+		return fail("interval: %v", err)
+	}
+	return l, nil
+}
+
+// pluralRules parses the plural rules, in the CLDR order of the categories.
+func (b Bundle) pluralRules() ([]PluralCategory, []plural.Rule, error) {
+	for name := range b.Plural {
+		if c, ok := parsePluralCategory(name); !ok || c == PluralOther {
+			return nil, nil, fmt.Errorf("plural: invalid category %q", name)
+		}
+	}
+
+	var categories []PluralCategory
+	var rules []plural.Rule
+	for _, c := range pluralOrder {
+		s, ok := b.Plural[c.String()]
+		if !ok {
+			continue
+		}
+		rule, err := plural.Parse(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		categories = append(categories, c)
+		rules = append(rules, rule)
+	}
+	return categories, rules, nil
+}
+
+// words returns the unit nouns of the bundle merged into the parent nouns,
+// checking every unit has the forms of the categories.
+func (b Bundle) words(parent []UnitForms, categories []PluralCategory) ([]UnitForms, error) {
+	words, err := mergeUnitForms(parent, b.Words)
+	if err != nil {
+		return nil, fmt.Errorf("words %v", err)
+	}
+	if len(b.Words) == 0 {
+		return words, nil
+	}
+	for u, forms := range words {
+		for _, c := range append(categories, PluralOther) {
+			if _, ok := forms[c]; !ok {
+				return nil, fmt.Errorf("words %s: no %s form", Unit(u), c)
+			}
+		}
+	}
+	return words, nil
+}
+
+// mergeUnitForms returns the forms keyed by unit name and category name merged into a copy
+// of the parent forms, ordered from years to nanoseconds.
+func mergeUnitForms(parent []UnitForms, forms map[string]map[string]string) ([]UnitForms, error) {
+	if len(forms) == 0 {
+		return parent, nil
+	}
+	merged := make([]UnitForms, int(Nanoseconds)+1)
+	copy(merged, parent)
+	for name, f := range forms {
+		u, err := ParseUnit(name)
+		if err != nil {
+			return nil, err
+		}
+		if merged[u], err = mergeForms(merged[u], f); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return merged, nil
+}
+
+// mergeForms returns the forms keyed by category name merged into a copy of the parent forms.
+func mergeForms(parent UnitForms, forms map[string]string) (UnitForms, error) {
+	if forms == nil {
+		return parent, nil
+	}
+	f := make(UnitForms, len(parent)+len(forms))
+	for c, form := range parent {
+		f[c] = form
+	}
+	for name, form := range forms {
+		c, ok := parsePluralCategory(name)
+		if !ok {
+			return nil, fmt.Errorf("invalid category %q", name)
+		}
+		f[c] = form
+	}
+	return f, nil
+}
+
+// RegisterBundle makes the locale of the bundle available to LookupLocale,
+// replacing the locale registered with the same name.
+func RegisterBundle(b Bundle) error {
+	l, err := b.Locale()
+	if err != nil {
+		return err
+	}
+	bundlesMu.Lock()
+	defer bundlesMu.Unlock()
+	bundleLocales[strings.ToLower(b.Name)] = l
+	return nil
+}
+
+// parentTag returns the BCP 47 tag without its last subtag, "" if it has a single subtag.
+func parentTag(tag string) string {
+	i := strings.LastIndexAny(tag, "-_")
+	if i < 0 {
+		return ""
+	}
+	return tag[:i]
+}
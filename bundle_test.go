@@ -0,0 +1,191 @@
+package durafmt
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBundleLocale(t *testing.T) {
+	f, err := os.Open("locales/uk.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	b, err := ReadBundle(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := b.Locale()
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, ok := LookupLocale("uk")
+	if !ok {
+		t.Fatal(`LookupLocale("uk") not found`)
+	}
+
+	testUkrainian := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{time.Hour + 2*time.Minute + 5*time.Second, "1 година 2 хвилини 5 секунд"},
+		{21*time.Hour + 11*time.Minute, "21 година 11 хвилин"},
+		{22 * time.Hour, "22 години"},
+	}
+	for _, table := range testUkrainian {
+		for _, l := range []Locale{loaded, generated} {
+			result := Parse(table.test).WithLocale(l).Accessible().String()
+			if result != table.expected {
+				t.Errorf("Parse(%q).WithLocale(%s).String() got %q, expected %q", table.test, l.Name, result, table.expected)
+			}
+		}
+	}
+	for n := int64(0); n < 300; n++ {
+		if loaded.Plural(n) != generated.Plural(n) {
+			t.Errorf("Plural(%d) got %s from the bundle and %s generated", n, loaded.Plural(n), generated.Plural(n))
+		}
+	}
+}
+
+func TestBundleLocaleErrors(t *testing.T) {
+	testBundles := []string{
+		`{"units": ["a"]}`,
+		`{"name": "xx", "units": ["a"], "compact": ["a"]}`,
+		`{"name": "xx", "unknown": true}`,
+		`{"name": "xx", "units": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "compact": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "plural": {"one": "n ="}}`,
+		`{"name": "xx", "units": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "compact": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "plural": {"single": "n = 1"}}`,
+		`{"name": "xx", "units": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "compact": ["1", "2", "3", "4", "5", "6", "7", "8", "9"], "plural": {"one": "n = 1"}, "words": {"hours": {"other": "hours"}}}`,
+	}
+
+	for _, input := range testBundles {
+		b, err := ReadBundle(strings.NewReader(input))
+		if err == nil {
+			_, err = b.Locale()
+		}
+		if err == nil {
+			t.Errorf("ReadBundle(%q).Locale() expected an error", input)
+		}
+	}
+}
+
+func TestBundleSixPluralCategories(t *testing.T) {
+	hours := map[string]string{"zero": "ساعة", "one": "ساعة", "two": "ساعتان", "few": "ساعات", "many": "ساعة", "other": "ساعة"}
+	b := Bundle{
+		Name:    "ar",
+		Units:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"},
+		Compact: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"},
+		Plural: map[string]string{
+			"zero": "n = 0",
+			"one":  "n = 1",
+			"two":  "n = 2",
+			"few":  "n % 100 = 3..10",
+			"many": "n % 100 = 11..99",
+		},
+		Words: make(map[string]map[string]string),
+	}
+	for u := Years; u <= Nanoseconds; u++ {
+		b.Words[u.String()] = hours
+	}
+	l, err := b.Locale()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCategories := []struct {
+		n        int64
+		category PluralCategory
+		expected string
+	}{
+		{0, PluralZero, "zero"},
+		{1, PluralOne, "one"},
+		{2, PluralTwo, "two"},
+		{103, PluralFew, "few"},
+		{11, PluralMany, "many"},
+		{100, PluralOther, "other"},
+	}
+	for _, table := range testCategories {
+		if result := l.Plural(table.n); result != table.category || result.String() != table.expected {
+			t.Errorf("Plural(%d) got %s, expected %s", table.n, result, table.expected)
+		}
+	}
+
+	result := Parse(2 * time.Hour).WithLocale(l).Accessible().String()
+	if expected := "2 ساعتان"; result != expected {
+		t.Errorf("Parse(2h).String() got %q, expected %q", result, expected)
+	}
+
+	delete(b.Words["hours"], "two")
+	if _, err := b.Locale(); err == nil {
+		t.Errorf("Locale() expected an error for the missing two form")
+	}
+}
+
+func TestLocaleFallback(t *testing.T) {
+	testFallback := []struct {
+		tag      string
+		expected string
+		ok       bool
+	}{
+		{"en", "en", true},
+		{"EN-gb", "en", true},
+		{"ru_RU", "ru", true},
+		{"uk-UA", "uk", true},
+		{"de-AT", "", false},
+		{"", "", false},
+	}
+
+	for _, table := range testFallback {
+		l, ok := LookupLocale(table.tag)
+		if ok != table.ok || l.Name != table.expected {
+			t.Errorf("LookupLocale(%q) got %q %v, expected %q %v", table.tag, l.Name, ok, table.expected, table.ok)
+		}
+	}
+	if l := ResolveLocale("de-AT"); l.Name != "ru" {
+		t.Errorf("ResolveLocale(%q) got %q, expected %q", "de-AT", l.Name, "ru")
+	}
+}
+
+func TestRegisterBundleMerge(t *testing.T) {
+	defer func() {
+		bundlesMu.Lock()
+		delete(bundleLocales, "en-x-test")
+		bundlesMu.Unlock()
+	}()
+
+	b := Bundle{
+		Name:        "en-x-test",
+		Units:       []string{"yrs", "wks", "days", "hrs", "mins", "secs", "ms", "µs", "ns"},
+		Words:       map[string]map[string]string{"hours": {"other": "hrs."}},
+		UnitPlurals: map[string]map[string]string{"hours": {"one": "hr", "other": "hrs"}},
+	}
+	if err := RegisterBundle(b); err != nil {
+		t.Fatal(err)
+	}
+	l, ok := LookupLocale("en-X-Test-extra")
+	if !ok || l.Name != "en-x-test" {
+		t.Fatalf("LookupLocale() got %q %v, expected %q", l.Name, ok, "en-x-test")
+	}
+
+	testMerge := []struct {
+		d        *Durafmt
+		expected string
+	}{
+		{Parse(2*time.Hour + 30*time.Minute).WithLocale(l), "2 hrs 30 mins"},
+		{Parse(2*time.Hour + 30*time.Minute).WithLocale(l).Accessible(), "2 hrs. 30 minutes"},
+		{Parse(time.Hour).WithLocale(l).Accessible(), "1 hour"},
+		{Parse(time.Hour + time.Minute).WithLocale(l), "1 hr 1 mins"},
+		{Parse(time.Millisecond).WithLocale(l), "1 ms"},
+		{Parse(12345 * time.Hour).WithLocale(l).WithDigitGrouping().LimitTo(Hours), "12,345 hrs"},
+	}
+	for _, table := range testMerge {
+		if result := table.d.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+
+	if err := RegisterBundle(Bundle{Name: "xx", Parent: "zz"}); err == nil {
+		t.Errorf("RegisterBundle() expected an error for an unknown parent")
+	}
+}
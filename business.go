@@ -0,0 +1,116 @@
+package durafmt
+
+import "time"
+
+// WorkingHours is a working schedule, counting only the time of working hours on working days,
+// e.g. the handling time of a ticket without nights and weekends.
+type WorkingHours struct {
+	// Days are the working days of the week, nil means Monday to Friday.
+	Days []time.Weekday
+	// Start and End are the wall clock times of the working hours, as offsets from midnight.
+	// An End not after Start ends the working hours on the next day, e.g. a night shift from 22:00
+	// to 6:00, counted on the working day it starts.
+	Start, End time.Duration
+	// Location is the time zone of the working hours, nil means the location of the times.
+	Location *time.Location
+	// Holidays are the non-working days besides the days of the week, nil means none.
+	Holidays Calendar
+}
+
+// Calendar tells the holidays of an organization.
+type Calendar interface {
+	// IsHoliday reports whether the day, a midnight in the location of the working hours,
+	// is a non-working day.
+	IsHoliday(day time.Time) bool
+}
+
+// CalendarFunc is an adapter to allow the use of ordinary functions as calendars.
+type CalendarFunc func(day time.Time) bool
+
+// IsHoliday calls f(day).
+func (f CalendarFunc) IsHoliday(day time.Time) bool {
+	return f(day)
+}
+
+// Holidays is a calendar of fixed dates, only their year, month and day count.
+type Holidays []time.Time
+
+// IsHoliday reports whether the date of the day is one of the holidays.
+func (h Holidays) IsHoliday(day time.Time) bool {
+	year, month, d := day.Date()
+	for _, holiday := range h {
+		if y, m, hd := holiday.Date(); y == year && m == month && hd == d {
+			return true
+		}
+	}
+	return false
+}
+
+// OfficeHours are the working hours from 9:00 to 18:00, Monday to Friday.
+var OfficeHours = WorkingHours{Start: 9 * time.Hour, End: 18 * time.Hour}
+
+// Between returns the working time from a to b, negative if b is before a.
+func (w WorkingHours) Between(a, b time.Time) *Durafmt {
+	if b.Before(a) {
+		return Parse(-w.working(b, a))
+	}
+	return Parse(w.working(a, b))
+}
+
+// working returns the working time from a to b, a not after b.
+func (w WorkingHours) working(a, b time.Time) time.Duration {
+	loc := w.Location
+	if loc == nil {
+		loc = a.Location()
+	}
+	a, b = a.In(loc), b.In(loc)
+
+	var total time.Duration
+	year, month, day := a.Date()
+	overnight := w.End <= w.Start
+	if overnight {
+		// the working hours of the day before may last past midnight into a.
+		year, month, day = time.Date(year, month, day-1, 0, 0, 0, 0, loc).Date()
+	}
+	for {
+		midnight := time.Date(year, month, day, 0, 0, 0, 0, loc)
+		if midnight.After(b) {
+			return total
+		}
+		if w.workday(midnight) {
+			// build the bounds from the wall clock, days with a DST change are not 24 hours long.
+			start := time.Date(year, month, day, 0, 0, 0, int(w.Start), loc)
+			end := time.Date(year, month, day, 0, 0, 0, int(w.End), loc)
+			if overnight {
+				end = time.Date(year, month, day+1, 0, 0, 0, int(w.End), loc)
+			}
+			if start.Before(a) {
+				start = a
+			}
+			if end.After(b) {
+				end = b
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		year, month, day = time.Date(year, month, day+1, 0, 0, 0, 0, loc).Date()
+	}
+}
+
+// workday reports whether the day is a working day.
+func (w WorkingHours) workday(day time.Time) bool {
+	if w.Holidays != nil && w.Holidays.IsHoliday(day) {
+		return false
+	}
+	weekday := day.Weekday()
+	if w.Days == nil {
+		return weekday != time.Saturday && weekday != time.Sunday
+	}
+	for _, d := range w.Days {
+		if d == weekday {
+			return true
+		}
+	}
+	return false
+}
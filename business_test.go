@@ -0,0 +1,61 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkingHoursBetween(t *testing.T) {
+	// Friday.
+	friday := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+	at := func(days int, hours, minutes int) time.Time {
+		return friday.AddDate(0, 0, days).Add(time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute)
+	}
+	weekend := WorkingHours{Days: []time.Weekday{time.Saturday, time.Sunday}, Start: 10 * time.Hour, End: 14 * time.Hour}
+	night := WorkingHours{Start: 22 * time.Hour, End: 6 * time.Hour}
+
+	testWorkingHours := []struct {
+		test     *Durafmt
+		expected time.Duration
+	}{
+		{OfficeHours.Between(at(0, 10, 0), at(0, 12, 30)), 150 * time.Minute},
+		{OfficeHours.Between(at(0, 7, 0), at(0, 23, 0)), 9 * time.Hour},
+		{OfficeHours.Between(at(0, 17, 0), at(4, 10, 0)), 11 * time.Hour},
+		{OfficeHours.Between(at(4, 10, 0), at(0, 17, 0)), -11 * time.Hour},
+		{OfficeHours.Between(at(1, 10, 0), at(2, 12, 0)), 0},
+		{weekend.Between(at(0, 12, 0), at(2, 12, 0)), 6 * time.Hour},
+		{night.Between(at(0, 20, 0), at(1, 8, 0)), 8 * time.Hour},
+		{night.Between(at(1, 2, 0), at(1, 4, 0)), 2 * time.Hour},
+		{night.Between(at(-1, 23, 0), at(0, 1, 0)), 2 * time.Hour},
+		{night.Between(at(2, 23, 0), at(3, 3, 0)), 0},
+		{night.Between(at(3, 21, 0), at(4, 7, 0)), 8 * time.Hour},
+	}
+
+	for _, table := range testWorkingHours {
+		if result := table.test.Duration(); result != table.expected {
+			t.Errorf("Duration() got %v, expected %v", result, table.expected)
+		}
+	}
+}
+
+func TestWorkingHoursHolidays(t *testing.T) {
+	newYear := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	start, end := newYear.Add(-24*time.Hour), newYear.Add(2*24*time.Hour)
+
+	testHolidays := []struct {
+		holidays Calendar
+		expected time.Duration
+	}{
+		{nil, 27 * time.Hour},
+		{Holidays{newYear.Add(15 * time.Hour)}, 18 * time.Hour},
+		{CalendarFunc(func(day time.Time) bool { return day.Month() == time.January }), 9 * time.Hour},
+	}
+
+	for _, table := range testHolidays {
+		w := OfficeHours
+		w.Holidays = table.holidays
+		if result := w.Between(start, end).Duration(); result != table.expected {
+			t.Errorf("Duration() got %v, expected %v", result, table.expected)
+		}
+	}
+}
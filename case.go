@@ -0,0 +1,67 @@
+package durafmt
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Case is the letter case applied to the formatted duration.
+type Case int
+
+const (
+	// AsIs leaves the output untouched.
+	AsIs Case = iota
+	// Lower converts the output to lower case, e.g. "2 ч.".
+	Lower
+	// Title upper-cases the first letter of every word, e.g. "2 Ч. 30 Мин.".
+	Title
+	// Upper converts the output to upper case, e.g. "2 Ч. 30 МИН.".
+	Upper
+)
+
+// WithCase sets the letter case of the output.
+func (d *Durafmt) WithCase(letterCase Case) *Durafmt {
+	c := d.Clone()
+	c.letterCase = letterCase
+	return c
+}
+
+// Sentence returns the formatted duration with its first letter upper-cased,
+// ready to start a sentence.
+func (d *Durafmt) Sentence() string {
+	return Capitalize(d.String())
+}
+
+// Capitalize upper-cases the first rune of s, leaving the rest untouched.
+// Unlike byte slicing it is safe for multi-byte letters such as Cyrillic.
+func Capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || !unicode.IsLower(r) {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// applyCase converts s to the letter case c.
+func applyCase(s string, c Case) string {
+	switch c {
+	case Lower:
+		return strings.ToLower(s)
+	case Upper:
+		return strings.ToUpper(s)
+	case Title:
+		var b strings.Builder
+		wordStart := true
+		for _, r := range s {
+			if wordStart {
+				r = unicode.ToTitle(r)
+			}
+			wordStart = unicode.IsSpace(r)
+			b.WriteRune(r)
+		}
+		return b.String()
+	default:
+		return s
+	}
+}
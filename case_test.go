@@ -0,0 +1,47 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWithCase(t *testing.T) {
+	testTimesWithCase := []struct {
+		test     time.Duration
+		c        Case
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, AsIs, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, Lower, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, Title, "2 Ч. 30 Мин."},
+		{2*time.Hour + 30*time.Minute, Upper, "2 Ч. 30 МИН."},
+		{-3 * time.Second, Title, "-3 Сек."},
+	}
+
+	for _, table := range testTimesWithCase {
+		result := Parse(table.test).WithCase(table.c).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithCase(%d).String() got %q, expected %q",
+				table.test, table.c, result, table.expected)
+		}
+	}
+}
+
+func TestCapitalize(t *testing.T) {
+	testStrings := []struct {
+		test     string
+		expected string
+	}{
+		{"", ""},
+		{"два часа", "Два часа"},
+		{"Два часа", "Два часа"},
+		{"2 ч.", "2 ч."},
+		{"около часа", "Около часа"},
+	}
+
+	for _, table := range testStrings {
+		if result := Capitalize(table.test); result != table.expected {
+			t.Errorf("Capitalize(%q) got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package durafmt
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClampMax sets the output format, rendering durations longer than max as "более года"
+// instead of an exact breakdown. max == 0 means no limit.
+func (d *Durafmt) ClampMax(max time.Duration) *Durafmt {
+	c := d.Clone()
+	c.clampMax = max
+	return c
+}
+
+// ClampMin sets the output format, rendering durations shorter than min as "менее секунды"
+// instead of an exact breakdown. min == 0 means no limit.
+func (d *Durafmt) ClampMin(min time.Duration) *Durafmt {
+	c := d.Clone()
+	c.clampMin = min
+	return c
+}
+
+// clamped returns the phrase of a duration out of the clamp range.
+func (d *Durafmt) clamped() (string, bool) {
+	duration := abs(d.duration)
+	locale := d.Locale()
+	switch {
+	case d.clampMax > 0 && duration > d.clampMax:
+		return fmt.Sprintf(locale.MoreThan, d.bound(d.clampMax)), true
+	case d.clampMin > 0 && duration < d.clampMin:
+		return fmt.Sprintf(locale.LessThan, d.bound(d.clampMin)), true
+	}
+	return "", false
+}
+
+// bound formats a clamp bound, as "года" when it is exactly one unit.
+func (d *Durafmt) bound(bound time.Duration) string {
+	locale := d.Locale()
+	for u := Years; u <= Microseconds; u++ {
+		if bound == u.Duration() && int(u) < len(locale.SingleUnits) {
+			return locale.SingleUnits[u]
+		}
+	}
+	c := d.withDuration(bound)
+	c.clampMin, c.clampMax = 0, 0
+	return c.fit(c.components())
+}
@@ -0,0 +1,32 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClamp(t *testing.T) {
+	year := Years.Duration()
+
+	testTimesClamp := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(2 * year).ClampMax(year), "более года"},
+		{Parse(year).ClampMax(year), "1 год"},
+		{Parse(300 * time.Millisecond).ClampMin(time.Second), "менее секунды"},
+		{Parse(-300 * time.Millisecond).ClampMin(time.Second), "менее секунды"},
+		{Parse(time.Second).ClampMin(time.Second), "1 сек."},
+		{Parse(100 * 24 * time.Hour).ClampMax(90 * 24 * time.Hour), "более 12 нед. 6 дн."},
+		{Parse(100 * 24 * time.Hour).ClampMax(90 * 24 * time.Hour).LimitToUnit(DaysKey), "более 90 дн."},
+		{Parse(2 * year).WithLocale(English).ClampMax(year), "more than a year"},
+		{Parse(time.Millisecond).WithLocale(English).ClampMin(time.Second).ClampMax(year), "less than a second"},
+		{Parse(time.Minute).WithLocale(English).ClampMin(time.Second).ClampMax(year), "1 minute"},
+	}
+
+	for _, table := range testTimesClamp {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package durafmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseClock creates a new *Durafmt struct from a clock time, "[-][HH:]MM:SS[.fff]"
+// such as "01:23:45", "83:20" or "1:02:03.456", as stopwatches, spreadsheets and media tools
+// show elapsed times. The first field may exceed 59, the following ones may not.
+func ParseClock(input string) (*Durafmt, error) {
+	s := strings.TrimPrefix(input, "-")
+	fields := strings.Split(s, ":")
+	valid := len(fields) == 2 || len(fields) == 3
+	for i := 1; valid && i < len(fields); i++ {
+		whole := strings.SplitN(fields[i], ".", 2)[0]
+		valid = len(whole) == 2 && whole < "60" && (i == len(fields)-1 || whole == fields[i])
+	}
+	duration, ok := parseSexagesimal(s, '.')
+	if !valid || !ok || fields[0] == "" {
+		return nil, errors.New("durafmt: invalid clock time " + strconv.Quote(input))
+	}
+	if len(s) < len(input) {
+		duration = -duration
+	}
+	return Parse(duration), nil
+}
+
+// Split formats the duration as a split time of race timing and benchmarks, minutes and seconds
+// with places decimals of a second, e.g. "1:23.456" or "12:03.9". Hours are shown when non-zero,
+// "1:02:03.4". The decimals are truncated like timing systems do.
+func (d *Durafmt) Split(places int) string {
+	if places < 0 {
+		places = 0
+	}
+	if places > 9 {
+		places = 9
+	}
+	duration := abs(d.Duration())
+	s := clock(duration)
+
+	if places > 0 {
+		fraction := strconv.FormatInt(int64(duration%time.Second), 10)
+		fraction = strings.Repeat("0", 9-len(fraction)) + fraction
+		s += "." + fraction[:places]
+	}
+	if d.Duration() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Runtime formats the duration the way music and video players show lengths,
+// e.g. "3:45" or "1:02:33", with hours only when non-zero. Fractions of a second are truncated.
+func (d *Durafmt) Runtime() string {
+	return d.Split(0)
+}
+
+// clock formats the whole seconds of duration as "m:ss", or "h:mm:ss" when hours are non-zero.
+func clock(duration time.Duration) string {
+	hours := int64(duration / time.Hour)
+	minutes := int64(duration % time.Hour / time.Minute)
+	seconds := int64(duration % time.Minute / time.Second)
+
+	s := strconv.FormatInt(minutes, 10) + ":" + twoDigits(seconds)
+	if hours > 0 {
+		s = strconv.FormatInt(hours, 10) + ":" + twoDigits(minutes) + ":" + twoDigits(seconds)
+	}
+	return s
+}
+
+// twoDigits formats v padded with a zero to two digits.
+func twoDigits(v int64) string {
+	if v < 10 {
+		return "0" + strconv.FormatInt(v, 10)
+	}
+	return strconv.FormatInt(v, 10)
+}
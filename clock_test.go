@@ -0,0 +1,83 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseClock(t *testing.T) {
+	testParseClock := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{"01:23:45", time.Hour + 23*time.Minute + 45*time.Second, false},
+		{"1:02:03.456", time.Hour + 2*time.Minute + 3456*time.Millisecond, false},
+		{"12:03", 12*time.Minute + 3*time.Second, false},
+		{"83:20", 83*time.Minute + 20*time.Second, false},
+		{"100:00:00", 100 * time.Hour, false},
+		{"0:00.5", 500 * time.Millisecond, false},
+		{"-00:01:30", -90 * time.Second, false},
+		{"1:60", 0, true},
+		{"1:75:00", 0, true},
+		{"1:2:03", 0, true},
+		{"1:02.5:03", 0, true},
+		{"1:2", 0, true},
+		{":30", 0, true},
+		{"1:02:03:04", 0, true},
+		{"83", 0, true},
+		{"1h", 0, true},
+		{"", 0, true},
+	}
+
+	for _, table := range testParseClock {
+		d, err := ParseClock(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParseClock(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParseClock(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	testSplit := []struct {
+		test     time.Duration
+		places   int
+		expected string
+	}{
+		{83456 * time.Millisecond, 3, "1:23.456"},
+		{723999 * time.Millisecond, 1, "12:03.9"},
+		{5 * time.Second, 2, "0:05.00"},
+		{time.Hour + 2*time.Minute + 3400*time.Millisecond, 1, "1:02:03.4"},
+		{-83456 * time.Millisecond, 0, "-1:23"},
+		{1500 * time.Nanosecond, 12, "0:00.000001500"},
+	}
+
+	for _, table := range testSplit {
+		if result := Parse(table.test).Split(table.places); result != table.expected {
+			t.Errorf("Parse(%v).Split(%d) got %q, expected %q", table.test, table.places, result, table.expected)
+		}
+	}
+}
+
+func TestRuntime(t *testing.T) {
+	testRuntime := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{3*time.Minute + 45*time.Second, "3:45"},
+		{time.Hour + 2*time.Minute + 33*time.Second, "1:02:33"},
+		{12*time.Minute + 5900*time.Millisecond, "12:05"},
+		{59 * time.Second, "0:59"},
+		{25 * time.Hour, "25:00:00"},
+	}
+
+	for _, table := range testRuntime {
+		if result := Parse(table.test).Runtime(); result != table.expected {
+			t.Errorf("Parse(%v).Runtime() got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Command durafmt-filter copies its input to its output, humanizing the Go durations
+// such as "1m23.456s" on the way, e.g.
+//
+//	go test -v ./... | durafmt-filter -locale en
+//
+// Lines are rewritten as they stream through, the input is never buffered whole.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ihippik/durafmt"
+)
+
+func main() {
+	locale := flag.String("locale", "ru", "locale of the durations")
+	limit := flag.Int("limit", 0, "number of units to display, all when 0")
+	flag.Parse()
+
+	l, ok := durafmt.LookupLocale(*locale)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "durafmt-filter: unknown locale %q\n", *locale)
+		os.Exit(2)
+	}
+	opts := []durafmt.Option{func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.WithLocale(l) }}
+	if *limit > 0 {
+		opts = append(opts, func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.LimitFirstN(*limit) })
+	}
+
+	if _, err := io.Copy(os.Stdout, durafmt.NewTextReader(os.Stdin, opts...)); err != nil {
+		fmt.Fprintln(os.Stderr, "durafmt-filter:", err)
+		os.Exit(1)
+	}
+}
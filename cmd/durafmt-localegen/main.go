@@ -0,0 +1,202 @@
+// Command durafmt-localegen compiles JSON locale bundles into Go source of the durafmt package,
+// so the locales cost nothing at run time and invalid plural rules or missing forms fail the build.
+// It runs with go generate from the repository root:
+//
+//	go run ./cmd/durafmt-localegen -o locales_gen.go locales/uk.json
+//
+// Every bundle becomes a Locale variable named after its title, found by durafmt.LookupLocale.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ihippik/durafmt"
+	"github.com/ihippik/durafmt/internal/plural"
+)
+
+// categories are the plural categories in the order the generated rules check them.
+var categories = []struct {
+	name, ident string
+}{
+	{"zero", "PluralZero"},
+	{"one", "PluralOne"},
+	{"two", "PluralTwo"},
+	{"few", "PluralFew"},
+	{"many", "PluralMany"},
+	{"other", "PluralOther"},
+}
+
+func main() {
+	output := flag.String("o", "", "output file, standard output when empty")
+	flag.Parse()
+
+	src, err := generate(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "durafmt-localegen:", err)
+		os.Exit(1)
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = ioutil.WriteFile(*output, src, 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "durafmt-localegen:", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the formatted Go source of the bundles in files.
+func generate(files []string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by durafmt-localegen from %s; DO NOT EDIT.\n\npackage durafmt\n", strings.Join(files, ", "))
+
+	var idents []string
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		b, err := durafmt.ReadBundle(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		if _, err := b.Locale(); err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		if b.Parent != "" || len(b.Units) != int(durafmt.Nanoseconds)+1 || len(b.Compact) != int(durafmt.Nanoseconds)+1 {
+			return nil, fmt.Errorf("%s: bundle %s is partial, register it at run time with durafmt.RegisterBundle", file, b.Name)
+		}
+		if b.Title == "" {
+			return nil, fmt.Errorf("%s: bundle %s has no title", file, b.Name)
+		}
+		if err := writeLocale(&buf, file, b); err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		idents = append(idents, b.Title)
+	}
+
+	fmt.Fprintf(&buf, "\n// generatedLocales are the locales compiled from bundles, found by LookupLocale.\n")
+	fmt.Fprintf(&buf, "var generatedLocales = []Locale{%s}\n", strings.Join(idents, ", "))
+	return format.Source(buf.Bytes())
+}
+
+// writeLocale writes the Locale variable of the validated bundle b and its plural function.
+func writeLocale(buf *bytes.Buffer, file string, b durafmt.Bundle) error {
+	pluralFunc := strings.ToLower(b.Title[:1]) + b.Title[1:] + "Plural"
+
+	fmt.Fprintf(buf, "\n// %s is the %q locale compiled from %s.\n", b.Title, b.Name, file)
+	fmt.Fprintf(buf, "var %s = Locale{\n", b.Title)
+	fmt.Fprintf(buf, "Name: %q,\n", b.Name)
+	fmt.Fprintf(buf, "Units: %s,\n", stringSlice(b.Units))
+	fmt.Fprintf(buf, "Compact: %s,\n", stringSlice(b.Compact))
+	if b.SingleUnits != nil {
+		fmt.Fprintf(buf, "SingleUnits: %s,\n", stringSlice(b.SingleUnits))
+	}
+	for _, field := range []struct{ name, value string }{
+		{"GroupSeparator", b.GroupSeparator},
+		{"DecimalSeparator", b.DecimalSeparator},
+		{"Digits", b.Digits},
+		{"ListSeparator", b.ListSeparator},
+		{"ListAnd", b.ListAnd},
+		{"Respectively", b.Respectively},
+		{"Remaining", b.Remaining},
+		{"Overdue", b.Overdue},
+		{"MoreThan", b.MoreThan},
+		{"LessThan", b.LessThan},
+		{"Segments", b.Segments},
+		{"SegmentsRest", b.SegmentsRest},
+	} {
+		if field.value != "" {
+			fmt.Fprintf(buf, "%s: %s,\n", field.name, strconv.Quote(field.value))
+		}
+	}
+	if len(b.Plural) > 0 {
+		fmt.Fprintf(buf, "Plural: %s,\n", pluralFunc)
+	}
+	for _, table := range []struct {
+		name  string
+		forms map[string]map[string]string
+	}{
+		{"UnitPlurals", b.UnitPlurals},
+		{"Words", b.Words},
+	} {
+		if len(table.forms) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "%s: []UnitForms{\n", table.name)
+		forms := make([]map[string]string, durafmt.Nanoseconds+1)
+		for name, f := range table.forms {
+			u, err := durafmt.ParseUnit(name)
+			if err != nil {
+				return err
+			}
+			forms[u] = f
+		}
+		for _, f := range forms {
+			if f == nil {
+				buf.WriteString("nil,\n")
+				continue
+			}
+			fmt.Fprintf(buf, "%s,\n", unitForms(f))
+		}
+		buf.WriteString("},\n")
+	}
+	if b.Docker != (durafmt.DockerPhrases{}) {
+		fmt.Fprintf(buf, "Docker: %s,\n", strings.TrimPrefix(fmt.Sprintf("%#v", b.Docker), "durafmt."))
+	}
+	if b.Layout != nil {
+		fmt.Fprintf(buf, "Layout: %s,\n", strings.TrimPrefix(fmt.Sprintf("%#v", *b.Layout), "durafmt."))
+	}
+	if b.Interval != nil {
+		fmt.Fprintf(buf, "Interval: UnitForms%s,\n", unitForms(b.Interval))
+	}
+	buf.WriteString("}\n")
+
+	if len(b.Plural) == 0 {
+		return nil
+	}
+	fmt.Fprintf(buf, "\n// %s returns the plural category of n in the %q locale.\n", pluralFunc, b.Name)
+	fmt.Fprintf(buf, "func %s(n int64) PluralCategory {\nif n < 0 {\nn = -n\n}\nswitch {\n", pluralFunc)
+	for _, c := range categories {
+		s, ok := b.Plural[c.name]
+		if !ok {
+			continue
+		}
+		rule, err := plural.Parse(s)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(buf, "case %s:\nreturn %s\n", rule.GoExpr("n"), c.ident)
+	}
+	buf.WriteString("}\nreturn PluralOther\n}\n")
+	return nil
+}
+
+// stringSlice returns the Go literal of a string slice.
+func stringSlice(s []string) string {
+	quoted := make([]string, len(s))
+	for i, v := range s {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// unitForms returns the Go literal of the forms keyed by category name, without the type.
+func unitForms(forms map[string]string) string {
+	var fields []string
+	for _, c := range categories {
+		if form, ok := forms[c.name]; ok {
+			fields = append(fields, c.ident+": "+strconv.Quote(form))
+		}
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
@@ -0,0 +1,51 @@
+//go:build js && wasm
+// +build js,wasm
+
+// Command durafmt-wasm exposes durafmt to JavaScript, so frontend code formats durations
+// with the same localization rules as the Go backend. Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o durafmt.wasm ./cmd/durafmt-wasm
+//
+// and, once loaded with wasm_exec.js, call
+//
+//	durafmt.format(5400000, {locale: "en", limitN: 1}) // "1 hour"
+//
+// The milliseconds may have a fraction. The options are the JSON form of durafmt.Options.
+package main
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/ihippik/durafmt"
+)
+
+func main() {
+	js.Global().Set("durafmt", js.ValueOf(map[string]interface{}{
+		"format": js.FuncOf(format),
+	}))
+	select {}
+}
+
+// format formats args[0] milliseconds with the options args[1], returning the string
+// or an Error on invalid arguments.
+func format(this js.Value, args []js.Value) interface{} {
+	if len(args) == 0 || args[0].Type() != js.TypeNumber {
+		return jsError("durafmt.format: milliseconds must be a number")
+	}
+	duration := durafmt.FromSeconds(args[0].Float() / 1000).Duration()
+
+	var opts durafmt.Options
+	if len(args) > 1 && args[1].Type() == js.TypeObject {
+		data := js.Global().Get("JSON").Call("stringify", args[1]).String()
+		if err := json.Unmarshal([]byte(data), &opts); err != nil {
+			return jsError("durafmt.format: " + err.Error())
+		}
+	}
+	return durafmt.New(duration, opts).String()
+}
+
+// jsError returns a JavaScript Error, a panic would stop the Go program instead of throwing.
+func jsError(msg string) interface{} {
+	return js.Global().Get("Error").New(msg)
+}
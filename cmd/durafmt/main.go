@@ -0,0 +1,327 @@
+// Command durafmt formats durations in a human readable form from the shell:
+//
+//	durafmt 354h22m3.24s                                    # 2 нед. 18 ч. 22 мин. 3 сек. 240 млс.
+//	durafmt diff 2024-01-01T00:00:00Z 2024-03-01T12:00:00Z  # 8 нед. 4 дн. 12 ч.
+//	durafmt since 1700000000
+//	durafmt watch --until 2024-12-31T23:59:59Z                # a live countdown
+//
+// Timestamps are RFC 3339 or seconds since the Unix epoch, with an optional fraction.
+// The flags precede the arguments, e.g. durafmt since --locale en --limit 2 1700000000,
+// flag parsing stops at the first argument or negative duration such as -90s,
+// and --json prints the units as JSON for scripts:
+//
+//	durafmt --json 1h30m | jq .units
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ihippik/durafmt"
+)
+
+const usage = `usage:
+	durafmt [flags] <duration>...                 humanize Go durations such as 1h30m
+	durafmt diff [flags] <timestamp> <timestamp>  humanize the time between two timestamps
+	durafmt since [flags] <timestamp>             humanize the time elapsed since a timestamp
+	durafmt watch [flags]                         repaint a stopwatch, or a countdown with --until, every second
+
+Timestamps are RFC 3339, e.g. 2024-01-01T00:00:00Z, or seconds since the Unix epoch.
+Flags precede the arguments, negative durations such as -90s are arguments.
+
+flags:
+`
+
+// errUsage reports invalid command line arguments.
+var errUsage = errors.New("invalid arguments")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// options are the output flags shared by every command.
+type options struct {
+	locale string
+	style  string
+	limit  int
+	json   bool
+	until  string
+}
+
+// output prints durations with the output flags.
+type output struct {
+	w    io.Writer
+	opts options
+}
+
+// jsonOutput is the output of --json.
+type jsonOutput struct {
+	Duration    string     `json:"duration"`
+	Nanoseconds int64      `json:"nanoseconds"`
+	Human       string     `json:"human"`
+	Units       []jsonUnit `json:"units"`
+}
+
+// jsonUnit is a unit of the breakdown printed by --json.
+type jsonUnit struct {
+	Unit  durafmt.Unit `json:"unit"`
+	Value int64        `json:"value"`
+}
+
+// run runs the command with the arguments and returns its exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	command := format
+	if len(args) > 0 {
+		switch args[0] {
+		case "diff":
+			command, args = diff, args[1:]
+		case "since":
+			command, args = since, args[1:]
+		case "watch":
+			command, args = watch, args[1:]
+		}
+	}
+
+	var opts options
+	flags := flag.NewFlagSet("durafmt", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	flags.StringVar(&opts.locale, "locale", "ru", "locale `tag` of the output, e.g. en or uk")
+	flags.StringVar(&opts.style, "style", "", "registered `style` of the output, e.g. docker or twitter")
+	flags.IntVar(&opts.limit, "limit", 0, "number of units to display, all when 0")
+	flags.BoolVar(&opts.json, "json", false, "print the duration and its units as JSON")
+	flags.StringVar(&opts.until, "until", "", "`timestamp` the watch command counts down to")
+
+	err := flags.Parse(separateNegatives(flags, args))
+	if err != nil && err != flag.ErrHelp {
+		fmt.Fprintln(stderr, "durafmt:", err)
+		err = errUsage
+	}
+	if err == nil {
+		err = command(flags.Args(), &output{stdout, opts})
+	}
+
+	switch {
+	case err == errUsage || err == flag.ErrHelp:
+		fmt.Fprint(stderr, usage)
+		flags.SetOutput(stderr)
+		flags.PrintDefaults()
+		return 2
+	case err != nil:
+		fmt.Fprintln(stderr, "durafmt:", err)
+		return 1
+	}
+	return 0
+}
+
+// separateNegatives returns args with "--" inserted before the first negative duration or number,
+// so "durafmt -90s" formats it instead of reporting an unknown flag. Flag values are skipped.
+func separateNegatives(flags *flag.FlagSet, args []string) []string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			return args
+		}
+		if negative(arg) {
+			return append(append(append([]string(nil), args[:i]...), "--"), args[i:]...)
+		}
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			continue
+		}
+		if f := flags.Lookup(name); f != nil {
+			if b, ok := f.Value.(interface{ IsBoolFlag() bool }); !ok || !b.IsBoolFlag() {
+				i++
+			}
+		}
+	}
+	return args
+}
+
+// negative reports whether arg is a negative number or Go duration.
+func negative(arg string) bool {
+	if _, err := strconv.ParseFloat(arg, 64); err == nil {
+		return true
+	}
+	_, err := durafmt.ParseString(arg)
+	return err == nil
+}
+
+// writeLine writes the duration on a line of its own.
+func (o *output) writeLine(d *durafmt.Durafmt) error {
+	d, err := o.apply(d)
+	if err != nil {
+		return err
+	}
+	if o.opts.json {
+		return o.writeJSON(d)
+	}
+	_, err = fmt.Fprintln(o.w, d)
+	return err
+}
+
+// repaint replaces the current terminal line with the duration.
+// With --json it prints a line per call instead.
+func (o *output) repaint(d *durafmt.Durafmt) error {
+	d, err := o.apply(d)
+	if err != nil {
+		return err
+	}
+	if o.opts.json {
+		return o.writeJSON(d)
+	}
+	_, err = fmt.Fprintf(o.w, "\r\x1b[K%s", d)
+	return err
+}
+
+// apply applies the output flags to the duration.
+func (o *output) apply(d *durafmt.Durafmt) (*durafmt.Durafmt, error) {
+	l, ok := durafmt.LookupLocale(o.opts.locale)
+	if !ok {
+		return nil, fmt.Errorf("unknown locale %q", o.opts.locale)
+	}
+	if _, ok := durafmt.LookupStyle(o.opts.style); o.opts.style != "" && !ok {
+		return nil, fmt.Errorf("unknown style %q, registered: %v", o.opts.style, durafmt.Styles())
+	}
+	return d.WithLocale(l).WithStyle(o.opts.style).LimitFirstN(o.opts.limit), nil
+}
+
+// writeJSON writes the duration and its units as a line of JSON.
+func (o *output) writeJSON(d *durafmt.Durafmt) error {
+	out := jsonOutput{
+		Duration:    d.Duration().String(),
+		Nanoseconds: int64(d.Duration()),
+		Human:       d.String(),
+		Units:       []jsonUnit{},
+	}
+	for _, u := range d.Units() {
+		out.Units = append(out.Units, jsonUnit{u.Unit, u.Value})
+	}
+	return json.NewEncoder(o.w).Encode(out)
+}
+
+// format prints every Go duration of args.
+func format(args []string, out *output) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	for _, arg := range args {
+		d, err := durafmt.ParseString(arg)
+		if err != nil {
+			return err
+		}
+		if err := out.writeLine(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diff prints the time between the two timestamps of args.
+func diff(args []string, out *output) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	a, err := parseTimestamp(args[0])
+	if err != nil {
+		return err
+	}
+	b, err := parseTimestamp(args[1])
+	if err != nil {
+		return err
+	}
+	return out.writeLine(durafmt.Between(a, b))
+}
+
+// since prints the time elapsed since the timestamp of args.
+func since(args []string, out *output) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	t, err := parseTimestamp(args[0])
+	if err != nil {
+		return err
+	}
+	return out.writeLine(durafmt.Since(t))
+}
+
+// watch repaints the time left until --until every second, or the time elapsed
+// since the start without it, until interrupted or the countdown ends.
+func watch(args []string, out *output) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+	var deadline time.Time
+	if out.opts.until != "" {
+		t, err := parseTimestamp(out.opts.until)
+		if err != nil {
+			return err
+		}
+		deadline = t
+	}
+	start := time.Now()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		var d time.Duration
+		if deadline.IsZero() {
+			d = time.Since(start).Round(time.Second)
+		} else {
+			d = time.Until(deadline).Round(time.Second)
+			if d < 0 {
+				d = 0
+			}
+		}
+		human := durafmt.Parse(d)
+		if d == 0 {
+			// a zero duration renders "", show "0 сек." instead.
+			human = human.LimitTo(durafmt.Seconds).WithColumns(1)
+		}
+		if err := out.repaint(human); err != nil {
+			return err
+		}
+		if !deadline.IsZero() && d == 0 {
+			break
+		}
+
+		select {
+		case <-ticker.C:
+		case <-interrupt:
+			if !out.opts.json {
+				fmt.Fprintln(out.w)
+			}
+			return nil
+		}
+	}
+	if !out.opts.json {
+		fmt.Fprintln(out.w)
+	}
+	return nil
+}
+
+// parseTimestamp parses an RFC 3339 timestamp or a number of seconds since the Unix epoch.
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	seconds, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q, expected RFC 3339 or Unix seconds", s)
+	}
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(math.Round(fraction*1e9))), nil
+}
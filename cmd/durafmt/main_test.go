@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	testRun := []struct {
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{[]string{"1h30m"}, 0, "1 ч. 30 мин.\n", ""},
+		{[]string{"90m", "2h"}, 0, "1 ч. 30 мин.\n2 ч.\n", ""},
+		{[]string{"-90s"}, 0, "-1 мин. 30 сек.\n", ""},
+		{[]string{"--locale", "en", "-90s"}, 0, "-1 minute 30 seconds\n", ""},
+		{[]string{"--json", "-90s"}, 0, `{"duration":"-1m30s","nanoseconds":-90000000000,"human":"-1 мин. 30 сек.","units":[{"unit":"minutes","value":1},{"unit":"seconds","value":30}]}` + "\n", ""},
+		{[]string{"--", "-90s"}, 0, "-1 мин. 30 сек.\n", ""},
+		{[]string{"--locale=en", "--limit", "1", "1h30m"}, 0, "1 hour\n", ""},
+		{[]string{"--style", "docker", "--locale", "en", "90m"}, 0, "2 hours\n", ""},
+		{[]string{"diff", "2024-01-01T00:00:00Z", "2024-03-01T12:00:00Z"}, 0, "8 нед. 4 дн. 12 ч.\n", ""},
+		{[]string{"diff", "--locale", "en", "1700000000", "1700000090.5"}, 0, "1 minute 30 seconds 500 milliseconds\n", ""},
+		{[]string{"diff", "1700000090", "-1"}, 0, "-53 года 45 нед. 2 дн. 22 ч. 14 мин. 51 сек.\n", ""},
+		{[]string{"diff", "--limit", "1", "-1", "0"}, 0, "1 сек.\n", ""},
+		{[]string{"watch", "--until", "1700000000"}, 0, "\r\x1b[K0 сек.\n", ""},
+		{[]string{"watch", "--locale", "en", "--until", "2000-01-01T00:00:00Z"}, 0, "\r\x1b[K0 seconds\n", ""},
+		{[]string{"watch", "1h"}, 2, "", "usage:"},
+		{[]string{}, 2, "", "usage:"},
+		{[]string{"diff", "1700000000"}, 2, "", "usage:"},
+		{[]string{"since"}, 2, "", "usage:"},
+		{[]string{"--unknown", "1h"}, 2, "", "durafmt: flag provided but not defined: -unknown\nusage:"},
+		{[]string{"soon"}, 1, "", "durafmt: time: invalid duration"},
+		{[]string{"--locale", "xx", "1h"}, 1, "", `durafmt: unknown locale "xx"`},
+		{[]string{"--style", "nope", "1h"}, 1, "", `durafmt: unknown style "nope"`},
+		{[]string{"diff", "yesterday", "0"}, 1, "", `durafmt: invalid timestamp "yesterday"`},
+		{[]string{"watch", "--until", "yesterday"}, 1, "", `durafmt: invalid timestamp "yesterday"`},
+	}
+
+	for _, table := range testRun {
+		var stdout, stderr bytes.Buffer
+		code := run(table.args, &stdout, &stderr)
+		if code != table.code || stdout.String() != table.stdout || !strings.Contains(stderr.String(), table.stderr) {
+			t.Errorf("run(%q) got %d, %q, %q, expected %d, %q, %q",
+				table.args, code, stdout.String(), stderr.String(), table.code, table.stdout, table.stderr)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	testParseTimestamp := []struct {
+		input    string
+		expected string
+		err      bool
+	}{
+		{"2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", false},
+		{"2024-01-01T03:00:00.5+03:00", "2024-01-01T00:00:00.5Z", false},
+		{"1700000000", "2023-11-14T22:13:20Z", false},
+		{"1700000000.25", "2023-11-14T22:13:20.25Z", false},
+		{"-1", "1969-12-31T23:59:59Z", false},
+		{"NaN", "", true},
+		{"Inf", "", true},
+		{"2024-01-01", "", true},
+	}
+
+	for _, table := range testParseTimestamp {
+		result, err := parseTimestamp(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("parseTimestamp(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && result.UTC().Format("2006-01-02T15:04:05.999999999Z07:00") != table.expected {
+			t.Errorf("parseTimestamp(%q) got %s, expected %s", table.input, result.UTC(), table.expected)
+		}
+	}
+}
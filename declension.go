@@ -0,0 +1,81 @@
+package durafmt
+
+// GrammaticalCase is the grammatical case unit words are declined in when a duration
+// is embedded in a sentence, e.g. genitive in "в течение 2 часов".
+type GrammaticalCase int
+
+const (
+	Nominative GrammaticalCase = iota
+	Genitive
+	Accusative
+	Instrumental
+)
+
+// WithGrammaticalCase sets the output format to full unit words declined in the case gc,
+// e.g. "2 часа 30 минут" in the nominative or "2 часами 30 минутами" in the instrumental.
+// Locales without declension use the same words in every case.
+func (d *Durafmt) WithGrammaticalCase(gc GrammaticalCase) *Durafmt {
+	c := d.Clone()
+	c.words = true
+	c.grammaticalCase = gc
+	return c
+}
+
+// Accessible sets the output format for screen readers and text-to-speech engines,
+// always using full unit words without dots or abbreviations, e.g. "2 часа 30 минут",
+// since "ч." and "мин." are read incorrectly. WithMaxLen drops units instead of abbreviating.
+func (d *Durafmt) Accessible() *Durafmt {
+	c := d.Clone()
+	c.words = true
+	c.accessible = true
+	return c
+}
+
+// word returns the full name of the unit u following a number of the plural category,
+// declined in the case gc, e.g. "часами".
+func (l Locale) word(u Unit, category PluralCategory, gc GrammaticalCase) string {
+	forms := l.Words
+	if declined, ok := l.Declensions[gc]; ok {
+		forms = declined
+	}
+	if int(u) >= len(forms) {
+		return l.Units[u]
+	}
+	return forms[u].Form(category)
+}
+
+// unit returns the unit name of the plural category, from UnitPlurals or Units.
+func (l Locale) unit(u Unit, category PluralCategory) string {
+	if forms, ok := l.unitPlurals(u); ok {
+		if form := forms.Form(category); form != "" {
+			return form
+		}
+	}
+	return l.Units[u]
+}
+
+// unitPlurals returns the forms of UnitPlurals of u if one of them is the name of Units.
+func (l Locale) unitPlurals(u Unit) (UnitForms, bool) {
+	if int(u) >= len(l.UnitPlurals) || !l.UnitPlurals[u].has(l.Units[u]) {
+		return nil, false
+	}
+	return l.UnitPlurals[u], true
+}
+
+// has reports whether form is one of the forms.
+func (f UnitForms) has(form string) bool {
+	for _, s := range f {
+		if s == form {
+			return true
+		}
+	}
+	return false
+}
+
+// plural returns the plural category of n, PluralOther if the locale has no plural rules.
+func (l Locale) plural(n int64) PluralCategory {
+	if l.Plural == nil {
+		return PluralOther
+	}
+	return l.Plural(n)
+}
@@ -0,0 +1,73 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWithGrammaticalCase(t *testing.T) {
+	testTimesWithCase := []struct {
+		test     time.Duration
+		gc       GrammaticalCase
+		locale   Locale
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, Nominative, Russian, "2 часа 30 минут"},
+		{21*time.Hour + time.Minute, Nominative, Russian, "21 час 1 минута"},
+		{5*time.Hour + 11*time.Minute, Nominative, Russian, "5 часов 11 минут"},
+		{2 * time.Hour, Genitive, Russian, "2 часов"},
+		{time.Hour, Genitive, Russian, "1 часа"},
+		{time.Minute, Accusative, Russian, "1 минуту"},
+		{3 * 168 * time.Hour, Accusative, Russian, "3 недели"},
+		{2*time.Hour + time.Minute, Instrumental, Russian, "2 часами 1 минутой"},
+		{2*time.Hour + time.Minute, Genitive, English, "2 hours 1 minute"},
+	}
+
+	for _, table := range testTimesWithCase {
+		result := Parse(table.test).WithLocale(table.locale).WithGrammaticalCase(table.gc).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithGrammaticalCase(%d).String() got %q, expected %q",
+				table.test, table.gc, result, table.expected)
+		}
+	}
+
+	result := Parse(time.Hour+29*time.Minute).RoundTo(Hours, RoundTenths).WithGrammaticalCase(Nominative).String()
+	if expected := "1,5 часа"; result != expected {
+		t.Errorf("RoundTo(Hours, RoundTenths).WithGrammaticalCase(Nominative) got %q, expected %q", result, expected)
+	}
+}
+
+func TestRussianPlural(t *testing.T) {
+	testNumbers := []struct {
+		test     int64
+		expected PluralCategory
+	}{
+		{1, PluralOne}, {21, PluralOne}, {101, PluralOne},
+		{2, PluralFew}, {4, PluralFew}, {22, PluralFew},
+		{0, PluralMany}, {5, PluralMany}, {11, PluralMany}, {12, PluralMany}, {14, PluralMany}, {111, PluralMany},
+	}
+
+	for _, table := range testNumbers {
+		if result := russianPlural(table.test); result != table.expected {
+			t.Errorf("russianPlural(%d) got %d, expected %d", table.test, result, table.expected)
+		}
+	}
+}
+
+func TestAccessible(t *testing.T) {
+	testTimesAccessible := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(2*time.Hour + 30*time.Minute), "2 часа 30 минут"},
+		{Parse(-(5*time.Hour + 1*time.Minute)), "-5 часов 1 минута"},
+		{Parse(2*time.Hour + 30*time.Minute).WithMaxLen(11), "2 часа"},
+		{Parse(2*time.Hour + 30*time.Minute).WithLocale(English), "2 hours 30 minutes"},
+	}
+
+	for _, table := range testTimesAccessible {
+		if result := table.test.Accessible().String(); result != table.expected {
+			t.Errorf("Accessible().String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
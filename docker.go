@@ -0,0 +1,54 @@
+package durafmt
+
+import "fmt"
+
+var (
+	// Docker is the coarse style of "docker ps", e.g. "About a minute" or "2 hours",
+	// registered as "docker".
+	Docker Style = StyleFunc(formatDocker)
+
+	// DockerAgo is the Docker style of a past event, e.g. "About a minute ago",
+	// registered as "docker-ago".
+	DockerAgo Style = StyleFunc(func(d *Durafmt) string {
+		return fmt.Sprintf(d.Locale().Docker.Ago, formatDocker(d))
+	})
+)
+
+func init() {
+	RegisterStyle("docker", Docker)
+	RegisterStyle("docker-ago", DockerAgo)
+}
+
+// formatDocker formats d the way Docker's units.HumanDuration does.
+func formatDocker(d *Durafmt) string {
+	phrases := d.Locale().Docker
+	duration := abs(d.duration)
+
+	unit := func(u Unit, v int64) string {
+		return d.renderUnit(component{unit: u, value: v})
+	}
+
+	var s string
+	if seconds := int64(duration.Seconds()); seconds < 1 {
+		s = phrases.LessThanASecond
+	} else if seconds < 60 {
+		s = unit(Seconds, seconds)
+	} else if minutes := int64(duration.Minutes()); minutes == 1 {
+		s = phrases.AboutAMinute
+	} else if minutes < 60 {
+		s = unit(Minutes, minutes)
+	} else if hours := int64(duration.Hours() + 0.5); hours == 1 {
+		s = phrases.AboutAnHour
+	} else if hours < 48 {
+		s = unit(Hours, hours)
+	} else if hours < 24*7*2 {
+		s = unit(Days, hours/24)
+	} else if hours < 24*30*2 {
+		s = unit(Weeks, hours/24/7)
+	} else if hours < 24*365*2 {
+		s = d.pad(component{value: hours / 24 / 30}) + d.unitSeparator() + phrases.Months
+	} else {
+		s = unit(Years, int64(duration.Hours()/24/365))
+	}
+	return applyCase(s, d.letterCase)
+}
@@ -0,0 +1,45 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDocker(t *testing.T) {
+	testTimesDocker := []struct {
+		test     time.Duration
+		locale   Locale
+		style    string
+		expected string
+	}{
+		{500 * time.Millisecond, English, "docker", "Less than a second"},
+		{time.Second, English, "docker", "1 second"},
+		{59 * time.Second, English, "docker", "59 seconds"},
+		{90 * time.Second, English, "docker", "About a minute"},
+		{-45 * time.Minute, English, "docker", "45 minutes"},
+		{80 * time.Minute, English, "docker", "About an hour"},
+		{47 * time.Hour, English, "docker", "47 hours"},
+		{5 * 24 * time.Hour, English, "docker", "5 days"},
+		{3 * 7 * 24 * time.Hour, English, "docker", "3 weeks"},
+		{100 * 24 * time.Hour, English, "docker", "3 months"},
+		{3 * 365 * 24 * time.Hour, English, "docker", "3 years"},
+		{2 * time.Hour, English, "docker-ago", "2 hours ago"},
+		{90 * time.Second, English, "docker-ago", "About a minute ago"},
+		{500 * time.Millisecond, Russian, "docker", "Менее секунды"},
+		{90 * time.Second, Russian, "docker-ago", "Около минуты назад"},
+		{100 * 24 * time.Hour, Russian, "docker", "3 мес."},
+		{2 * time.Hour, Russian, "docker-ago", "2 ч. назад"},
+	}
+
+	for _, table := range testTimesDocker {
+		result := Parse(table.test).WithLocale(table.locale).WithStyle(table.style).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithStyle(%q).String() got %q, expected %q",
+				table.test, table.style, result, table.expected)
+		}
+	}
+
+	if result := Docker.Format(Parse(time.Hour)); result != "Около часа" {
+		t.Errorf("Docker.Format() got %q, expected %q", result, "Около часа")
+	}
+}
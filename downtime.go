@@ -0,0 +1,14 @@
+package durafmt
+
+import (
+	"math"
+	"time"
+)
+
+// AllowedDowntime returns the downtime an availability in percent permits over the period,
+// for error budget reports, e.g. 99.99 over 30 days is "4 мин. 19 сек. 200 млс.".
+// Availabilities out of 0-100 are clamped, NaN permits no downtime.
+func AllowedDowntime(availability float64, period time.Duration) *Durafmt {
+	availability = math.Max(0, math.Min(100, availability))
+	return Parse(saturate(float64(period) * (100 - availability) / 100))
+}
@@ -0,0 +1,31 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAllowedDowntime(t *testing.T) {
+	day := 24 * time.Hour
+
+	testAllowedDowntime := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{AllowedDowntime(99.99, 30*day), "4 мин. 19 сек. 200 млс."},
+		{AllowedDowntime(99.9, 365*day).LimitFirstN(2), "8 ч. 45 мин."},
+		{AllowedDowntime(99.999, 7*day).DropBelow(Seconds), "6 сек."},
+		{AllowedDowntime(90, day), "2 ч. 24 мин."},
+		{AllowedDowntime(100, day), ""},
+		{AllowedDowntime(120, day), ""},
+		{AllowedDowntime(-5, day), "1 дн."},
+		{AllowedDowntime(math.NaN(), day), ""},
+	}
+
+	for _, table := range testAllowedDowntime {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("AllowedDowntime() got %q, expected %q", result, table.expected)
+		}
+	}
+}
@@ -4,12 +4,17 @@ package durafmt
 import (
 	"errors"
 	"fmt"
+	"io"
 	"regexp"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 )
 
+// Unit keys accepted by LimitToUnit.
+//
+// Deprecated: use the Unit constants with LimitTo.
 const (
 	YearsKey        = "лет"
 	WeeksKey        = "нед."
@@ -21,51 +26,303 @@ const (
 	MicrosecondsKey = "мкс."
 )
 
+const (
+	// NBSP is a no-break space, keeps a number and its unit on the same line.
+	NBSP = "\u00a0"
+	// NarrowNBSP is a narrow no-break space, the typographic choice between a number and its unit.
+	NarrowNBSP = "\u202f"
+)
+
 var (
-	units      = []string{YearsKey, WeeksKey, DaysKey, HoursKey, MinutesKey, SecondsKey, MillisecondsKey, MicrosecondsKey}
-	unitsShort = []string{"л", "н", "в", "ч", "м", "с", "мс", "мкс"}
+	units        = []string{"лет", "нед.", "дн.", "ч.", "мин.", "сек.", "млс.", "мкс.", "нс."}
+	unitsShort   = []string{"л", "н", "в", "ч", "м", "с", "мс", "мкс", "нс"}
+	unitsCompact = []string{"г", "н", "д", "ч", "м", "с", "мс", "мкс", "нс"}
+	unitSizes    = []int64{365 * 24 * 3600 * 1e9, 7 * 24 * 3600 * 1e9, 24 * 3600 * 1e9, 3600 * 1e9, 60 * 1e9, 1e9, 1e6, 1e3, 1}
 )
 
 // Durafmt holds the parsed duration and the original input duration.
+// Methods setting the output format return a modified copy and never change the receiver,
+// so a base Durafmt can be safely specialized in different goroutines.
 type Durafmt struct {
 	duration  time.Duration
 	input     string // Used as reference.
 	limitN    int    // Non-zero to limit only first N elements to output.
-	limitUnit string // Non-empty to limit max unit
+	limitUnit Unit   // Biggest unit, Years means no restriction.
+	width     int    // Non-zero to pad numbers to width.
+	columns   int    // Non-zero to output exactly N units, zero values included.
+	maxLen    int    // Non-zero to limit the output to N runes.
+	fitUnits  bool   // Fit maxLen by dropping units only, never in the compact style.
+
+	calendar  bool          // Count calendar years between two dates.
+	years     int64         // Number of the calendar years.
+	yearsSpan time.Duration // Length of the calendar years.
+
+	autoPrecision bool // Show only the biggest unit and the next smaller one.
+	dropBelow     bool // Drop units smaller than minUnit.
+	minUnit       Unit
+	decimalPlaces int // Non-zero to output a single decimalUnit with decimal places.
+	decimalUnit   Unit
+	ceil          bool // Round the smallest displayed unit up.
+	round         bool // Round the smallest displayed unit half up.
+	nanoseconds   bool // Show the nanosecond remainder.
+
+	clampMin time.Duration // Non-zero to render shorter durations as "менее ...".
+	clampMax time.Duration // Non-zero to render longer durations as "более ...".
+	fuzzy    FuzzyLevel    // Non-zero to describe durations colloquially.
+
+	words           bool // Full unit words instead of abbreviations.
+	numberWords     bool // Numbers spelled out in words.
+	accessible      bool // Never abbreviate, for screen readers.
+	grammaticalCase GrammaticalCase
+
+	abbreviations map[Unit]string // Overrides the unit abbreviations of the locale.
+	skipUnits     uint            // Bit set of units never displayed.
+
+	letterCase Case    // Letter case applied to the output.
+	unitSep    string  // Non-empty to replace the space between a number and its unit.
+	locale     *Locale // Nil means Russian.
+	grouping   bool    // Separate thousands in large numbers.
+
+	unitRenderer   func(u Unit, v int64) string // Non-nil to render every unit.
+	numberRenderer func(u Unit, v int64) string // Non-nil to render every number.
+	style          Style                        // Non-nil to format with a registered style.
+	now            func() time.Time             // Non-nil to pin the current time of relative phrases.
+
+	cache *formatCache // Non-nil to remember the output, every copy gets its own.
 }
 
 // LimitToUnit sets the output format, you will not have unit bigger than the UNIT specified. UNIT = "" means no restriction.
+// UNIT is one of the unit keys, e.g. DaysKey, or an English identifier accepted by ParseUnit, e.g. "hours".
+// Unknown units mean no restriction.
 func (d *Durafmt) LimitToUnit(unit string) *Durafmt {
-	d.limitUnit = unit
-	return d
+	for i, key := range units {
+		if key == unit {
+			return d.LimitTo(Unit(i))
+		}
+	}
+	u, err := ParseUnit(unit)
+	if err != nil {
+		return d.LimitTo(Years)
+	}
+	return d.LimitTo(u)
+}
+
+// LimitTo sets the output format, you will not have unit bigger than u. u = Years means no restriction.
+// Use ParseUnit to get the unit from an English identifier such as "hours".
+func (d *Durafmt) LimitTo(u Unit) *Durafmt {
+	c := d.Clone()
+	c.limitUnit = u
+	return c
 }
 
 // LimitFirstN sets the output format, outputing only first N elements. n == 0 means no limit.
 func (d *Durafmt) LimitFirstN(n int) *Durafmt {
-	d.limitN = n
-	return d
+	c := d.Clone()
+	c.limitN = n
+	return c
+}
+
+// WithWidth sets the output format, padding every number with spaces up to width runes,
+// so durations line up in tabwriter or monospace tables. width == 0 means no padding.
+func (d *Durafmt) WithWidth(width int) *Durafmt {
+	c := d.Clone()
+	c.width = width
+	return c
+}
+
+// WithColumns sets the output format, outputing exactly n units including zero values,
+// starting from the unit set by LimitTo or the biggest non-zero unit.
+// Unit names are padded to the same width. n == 0 means no fixed columns.
+func (d *Durafmt) WithColumns(n int) *Durafmt {
+	c := d.Clone()
+	c.columns = n
+	return c
+}
+
+// WithAutoPrecision sets the output format, adapting the precision to the magnitude:
+// µs and ms for sub-second values, seconds and ms under a minute, minutes and seconds
+// under an hour and so on, showing the biggest unit and the next smaller one.
+func (d *Durafmt) WithAutoPrecision() *Durafmt {
+	c := d.Clone()
+	c.autoPrecision = true
+	return c
+}
+
+// DropBelow sets the output format, discarding every unit smaller than u,
+// e.g. DropBelow(Seconds) never shows ms and µs in user-facing text.
+func (d *Durafmt) DropBelow(u Unit) *Durafmt {
+	c := d.Clone()
+	c.dropBelow = true
+	c.minUnit = u
+	return c
+}
+
+// WithNanoseconds sets the output format, showing the nanosecond remainder
+// that is otherwise dropped, e.g. "1 мкс. 500 нс.".
+func (d *Durafmt) WithNanoseconds() *Durafmt {
+	c := d.Clone()
+	c.nanoseconds = true
+	return c
+}
+
+// Ceil sets the output format, rounding the smallest displayed unit up when smaller units
+// are not shown, so countdowns never show "0 сек." while time remains.
+func (d *Durafmt) Ceil() *Durafmt {
+	c := d.Clone()
+	c.ceil = true
+	return c
+}
+
+// Floor sets the output format, truncating the smallest displayed unit when smaller units
+// are not shown, so elapsed timers never overstate. This is the default.
+func (d *Durafmt) Floor() *Durafmt {
+	c := d.Clone()
+	c.ceil = false
+	return c
+}
+
+// WithMaxLen sets the output format, dropping trailing units or falling back to the compact
+// style ("2ч 30м") until the output fits within n runes. n == 0 means no limit.
+func (d *Durafmt) WithMaxLen(n int) *Durafmt {
+	c := d.Clone()
+	c.maxLen = n
+	c.fitUnits = false
+	return c
+}
+
+// FitWidth sets the output format, showing as many leading units as fit within n runes,
+// e.g. for responsive table cells. Unlike WithMaxLen it never switches to the compact style,
+// the biggest unit is always shown. n == 0 means no limit.
+func (d *Durafmt) FitWidth(n int) *Durafmt {
+	c := d.Clone()
+	c.maxLen = n
+	c.fitUnits = true
+	return c
+}
+
+// WithUnitSeparator sets the output format, placing sep between every number and its unit,
+// e.g. NBSP so "2 ч." never wraps across lines in HTML and PDF rendering. sep == "" means a space.
+func (d *Durafmt) WithUnitSeparator(sep string) *Durafmt {
+	c := d.Clone()
+	c.unitSep = sep
+	return c
+}
+
+// WithAbbreviations sets the output format, overriding the unit abbreviations of the locale,
+// e.g. {Hours: "час", Minutes: "мин"} without dots or corporate-style {Hours: "h", Minutes: "m"}.
+// Units missing from abbrs keep the abbreviations of the locale.
+func (d *Durafmt) WithAbbreviations(abbrs map[Unit]string) *Durafmt {
+	c := d.Clone()
+	c.abbreviations = make(map[Unit]string, len(abbrs))
+	for u, abbr := range abbrs {
+		c.abbreviations[u] = abbr
+	}
+	return c
+}
+
+// WithUnitRenderer sets the output format, rendering every displayed unit with render,
+// e.g. to wrap it in HTML spans. The split of the duration and the order of units are kept.
+// A nil render restores the default rendering.
+func (d *Durafmt) WithUnitRenderer(render func(u Unit, v int64) string) *Durafmt {
+	c := d.Clone()
+	c.unitRenderer = render
+	return c
+}
+
+// WithNumberRenderer sets the output format, rendering the number of every displayed unit with render,
+// e.g. RomanNumerals or ZeroPadded(2). The unit words, separators and fractions are kept.
+// A nil render restores the default rendering.
+func (d *Durafmt) WithNumberRenderer(render func(u Unit, v int64) string) *Durafmt {
+	c := d.Clone()
+	c.numberRenderer = render
+	return c
+}
+
+// formatCache holds the output of a Durafmt once it is computed.
+type formatCache struct {
+	once   sync.Once
+	output string
+}
+
+// Clone returns a copy of d with the same duration and output format.
+// A nil d gives a zero duration, so the setters work on optional fields.
+func (d *Durafmt) Clone() *Durafmt {
+	if d == nil {
+		return &Durafmt{cache: new(formatCache)}
+	}
+	c := *d
+	c.cache = new(formatCache)
+	return &c
+}
+
+// Equal reports whether d and other hold the same duration formatted to the same output.
+func (d *Durafmt) Equal(other *Durafmt) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	return d.duration == other.duration && d.String() == other.String()
+}
+
+// Compare compares the durations of d and other, returning -1, 0 or +1
+// if d is shorter, equal or longer than other. A nil Durafmt is the shortest.
+func (d *Durafmt) Compare(other *Durafmt) int {
+	switch {
+	case d == nil && other == nil:
+		return 0
+	case d == nil:
+		return -1
+	case other == nil:
+		return 1
+	case d.duration < other.duration:
+		return -1
+	case d.duration > other.duration:
+		return 1
+	}
+	return 0
 }
 
 func (d *Durafmt) Duration() time.Duration {
+	if d == nil {
+		return 0
+	}
 	return d.duration
 }
 
 // Parse creates a new *Durafmt struct, returns error if input is invalid.
 func Parse(dinput time.Duration) *Durafmt {
 	input := dinput.String()
-	return &Durafmt{dinput, input, 0, ""}
+	return &Durafmt{duration: dinput, input: input, cache: new(formatCache)}
 }
 
 // ParseShort creates a new *Durafmt struct, short form, returns error if input is invalid.
 // It's shortcut for `Parse(dur).LimitFirstN(1)`
 func ParseShort(dinput time.Duration) *Durafmt {
 	input := dinput.String()
-	return &Durafmt{dinput, input, 1, ""}
+	return &Durafmt{duration: dinput, input: input, limitN: 1, cache: new(formatCache)}
+}
+
+// Format returns the human readable duration, the shortcut of `Parse(dur).String()`.
+func Format(dinput time.Duration) string {
+	return Parse(dinput).String()
+}
+
+// FormatShort returns the biggest unit of the human readable duration,
+// the shortcut of `ParseShort(dur).String()`.
+func FormatShort(dinput time.Duration) string {
+	return ParseShort(dinput).String()
+}
+
+// FormatN returns the first n units of the human readable duration,
+// the shortcut of `Parse(dur).LimitFirstN(n).String()`.
+func FormatN(dinput time.Duration, n int) string {
+	return Parse(dinput).LimitFirstN(n).String()
 }
 
 // ParseString creates a new *Durafmt struct from a string.
+// Spaces and commas between components are ignored, "2h 30m" or "2h,\u00a030m".
 // returns an error if input is invalid.
 func ParseString(input string) (*Durafmt, error) {
+	input = normalize(input)
 	if input == "0" || input == "-0" {
 		return nil, errors.New("durafmt: missing unit in duration " + input)
 	}
@@ -73,13 +330,14 @@ func ParseString(input string) (*Durafmt, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Durafmt{duration, input, 0, ""}, nil
+	return &Durafmt{duration: duration, input: input, cache: new(formatCache)}, nil
 }
 
 // ParseStringShort creates a new *Durafmt struct from a string, short form
 // returns an error if input is invalid.
 // It's shortcut for `ParseString(durStr)` and then calling `LimitFirstN(1)`
 func ParseStringShort(input string) (*Durafmt, error) {
+	input = normalize(input)
 	if input == "0" || input == "-0" {
 		return nil, errors.New("durafmt: missing unit in duration " + input)
 	}
@@ -87,130 +345,422 @@ func ParseStringShort(input string) (*Durafmt, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Durafmt{duration, input, 1, ""}, nil
+	return &Durafmt{duration: duration, input: input, limitN: 1, cache: new(formatCache)}, nil
 }
 
 // String parses d *Durafmt into a human readable duration.
+// The output is computed once and remembered, the methods setting the output format
+// return copies which compute their own. A nil d gives the placeholder set with SetNilPlaceholder.
 func (d *Durafmt) String() string {
+	if d == nil {
+		return NilPlaceholder()
+	}
+	if d.cache == nil {
+		return d.format()
+	}
+	d.cache.once.Do(func() {
+		d.cache.output = d.format()
+	})
+	return d.cache.output
+}
+
+// format computes the human readable duration.
+func (d *Durafmt) format() string {
+	if d.style != nil {
+		return d.formatStyle()
+	}
+	if phrase, ok := d.clamped(); ok {
+		return applyCase(phrase, d.letterCase)
+	}
+	if phrase, ok := d.fuzzed(); ok {
+		return applyCase(phrase, d.letterCase)
+	}
+	return applyCase(d.fit(d.components()), d.letterCase)
+}
+
+// fit joins the components into a duration string no longer than maxLen runes.
+func (d *Durafmt) fit(components []component) string {
+	duration := d.join(components, d.renderUnit)
+	if d.maxLen <= 0 || utf8.RuneCountInString(duration) <= d.maxLen {
+		return duration
+	}
+
+	// drop trailing units, preferring the compact style over losing a unit.
+	for n := len(components); n > 0; n-- {
+		if duration = d.join(components[:n], d.renderUnit); utf8.RuneCountInString(duration) <= d.maxLen {
+			return duration
+		}
+		if d.accessible || d.fitUnits {
+			continue
+		}
+		if duration = d.join(components[:n], d.renderCompact); utf8.RuneCountInString(duration) <= d.maxLen {
+			return duration
+		}
+	}
+
+	return duration
+}
+
+// Parts returns every displayed unit rendered separately, e.g. ["2 ч.", "30 мин."],
+// so UIs can style, wrap or animate individual pieces. The minus sign of a negative
+// duration is kept on the first part.
+func (d *Durafmt) Parts() []string {
+	if d == nil {
+		return nil
+	}
+	if phrase, ok := d.clamped(); ok {
+		return []string{applyCase(phrase, d.letterCase)}
+	}
+	if phrase, ok := d.fuzzed(); ok {
+		return []string{applyCase(phrase, d.letterCase)}
+	}
+
+	parts := d.render(d.components(), d.renderUnit)
+	for i := range parts {
+		parts[i] = applyCase(strings.TrimRight(parts[i], " "), d.letterCase)
+	}
+	if len(parts) > 0 && d.negative() {
+		parts[0] = signed(parts[0])
+	}
+	return parts
+}
+
+// WriteTo writes the human readable duration to w, so it streams directly into responses
+// and log writers. It implements io.WriterTo.
+func (d *Durafmt) WriteTo(w io.Writer) (int64, error) {
+	if d == nil {
+		n, err := io.WriteString(w, NilPlaceholder())
+		return int64(n), err
+	}
+	var parts []string
+	// styles, length limits, lists and padded columns need the whole string.
+	if d.style == nil && d.maxLen <= 0 && d.columns == 0 && !d.Locale().Layout.List {
+		parts = d.Parts()
+	}
+	if len(parts) == 0 {
+		n, err := io.WriteString(w, d.String())
+		return int64(n), err
+	}
+
+	separator := " "
+	if d.Locale().Layout.Joined {
+		separator = ""
+	}
+	var written int64
+	for i, part := range parts {
+		if i > 0 {
+			part = separator + part
+		}
+		n, err := io.WriteString(w, part)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// join renders the components with render and joins them into a duration string.
+func (d *Durafmt) join(components []component, render func(component) string) string {
 	var duration string
 
 	// Check for minus durations.
-	if string(d.input[0]) == "-" {
-		duration += "-"
-		d.duration = -d.duration
+	parts := d.render(components, render)
+	if d.negative() {
+		if len(parts) == 0 {
+			duration += "-"
+		} else {
+			parts[0] = signed(parts[0])
+		}
 	}
 
-	var microseconds int64
-	var milliseconds int64
-	var seconds int64
-	var minutes int64
-	var hours int64
-	var days int64
-	var weeks int64
-	var years int64
-	var shouldConvert = false
+	switch layout := d.Locale().Layout; {
+	case layout.List:
+		duration += joinList(parts, d.Locale())
+	case layout.Joined:
+		duration += strings.Join(parts, "")
+	default:
+		duration += strings.Join(parts, " ")
+	}
 
-	remainingSecondsToConvert := int64(d.duration / time.Microsecond)
+	// trim any remaining spaces.
+	return strings.TrimRight(duration, " ")
+}
 
-	// Convert duration.
-	if d.limitUnit == "" {
-		shouldConvert = true
+// signed puts a minus sign before the number of part, taking its place from the width padding.
+func signed(part string) string {
+	number := strings.TrimLeft(part, " ")
+	if n := len(part) - len(number); n > 0 {
+		return part[:n-1] + "-" + number
 	}
+	return "-" + number
+}
 
-	if d.limitUnit == YearsKey || shouldConvert {
-		years = remainingSecondsToConvert / (365 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= years * 365 * 24 * 3600 * 1000000
-		shouldConvert = true
+// render renders every component with render, in the order of the locale layout.
+func (d *Durafmt) render(components []component, render func(component) string) []string {
+	parts := make([]string, 0, len(components))
+	for _, c := range components {
+		parts = append(parts, render(c))
 	}
-
-	if d.limitUnit == WeeksKey || shouldConvert {
-		weeks = remainingSecondsToConvert / (7 * 24 * 3600 * 1000000)
-		remainingSecondsToConvert -= weeks * 7 * 24 * 3600 * 1000000
-		shouldConvert = true
+	if d.Locale().Layout.SmallestFirst {
+		for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+			parts[i], parts[j] = parts[j], parts[i]
+		}
 	}
+	return parts
+}
 
-	if d.limitUnit == DaysKey || shouldConvert {
-		days = remainingSecondsToConvert / (24 * 3600 * 1000000)
-		remainingSecondsToConvert -= days * 24 * 3600 * 1000000
-		shouldConvert = true
+// negative reports whether the input duration has a minus sign.
+func (d *Durafmt) negative() bool {
+	return strings.HasPrefix(d.input, "-")
+}
+
+// component is a single displayed unit of the duration.
+type component struct {
+	unit     Unit
+	value    int64
+	fraction string // Non-empty decimal digits rendered after value.
+}
+
+// convert splits the absolute duration into values indexed like units.
+// Units bigger than limitUnit and skipped units are left at zero.
+func (d *Durafmt) convert() []int64 {
+	values := make([]int64, len(units))
+
+	// negate in uint64, math.MinInt64 has no positive int64 counterpart.
+	remainingSecondsToConvert := uint64(d.duration)
+	if d.duration < 0 {
+		remainingSecondsToConvert = -remainingSecondsToConvert
 	}
 
-	if d.limitUnit == HoursKey || shouldConvert {
-		hours = remainingSecondsToConvert / (3600 * 1000000)
-		remainingSecondsToConvert -= hours * 3600 * 1000000
-		shouldConvert = true
+	smallest := d.smallest()
+	for i := Years; i <= smallest; i++ {
+		// the smallest unit always receives the remainder.
+		if (i < d.limitUnit || d.skipped(i)) && i != smallest {
+			continue
+		}
+		// calendar years have their own length, 366 days in leap years.
+		if i == Years && d.calendar {
+			values[i] = d.years
+			remainingSecondsToConvert -= uint64(d.yearsSpan)
+			continue
+		}
+		values[i] = int64(remainingSecondsToConvert / uint64(unitSizes[i]))
+		remainingSecondsToConvert -= uint64(values[i] * unitSizes[i])
 	}
 
-	if d.limitUnit == MinutesKey || shouldConvert {
-		minutes = remainingSecondsToConvert / (60 * 1000000)
-		remainingSecondsToConvert -= minutes * 60 * 1000000
-		shouldConvert = true
+	return values
+}
+
+// smallest returns the smallest unit the duration is split into.
+func (d *Durafmt) smallest() Unit {
+	if d.nanoseconds {
+		return Nanoseconds
 	}
+	return Microseconds
+}
 
-	if d.limitUnit == SecondsKey || shouldConvert {
-		seconds = remainingSecondsToConvert / 1000000
-		remainingSecondsToConvert -= seconds * 1000000
-		shouldConvert = true
+// components returns every displayed unit of the duration.
+func (d *Durafmt) components() []component {
+	if d == nil {
+		return nil
+	}
+	if d.decimalPlaces > 0 {
+		return []component{d.decimalComponent()}
 	}
 
-	if d.limitUnit == MillisecondsKey || shouldConvert {
-		milliseconds = remainingSecondsToConvert / 1000
-		remainingSecondsToConvert -= milliseconds * 1000
+	components := d.truncatedComponents()
+
+	// round the smallest displayed unit if anything smaller was dropped.
+	if d.ceil || d.round {
+		smallest := d.smallest()
+		if len(components) > 0 {
+			smallest = components[len(components)-1].unit
+		}
+		duration := abs(d.duration)
+		rounded := duration.Round(smallest.Duration())
+		if d.ceil {
+			rounded = roundUp(duration, smallest.Duration())
+		}
+		if rounded != duration {
+			if d.duration < 0 {
+				rounded = -rounded
+			}
+			c := d.withDuration(rounded)
+			c.ceil, c.round = false, false
+			return c.components()
+		}
 	}
 
-	microseconds = remainingSecondsToConvert
+	return components
+}
 
-	// Create a map of the converted duration time.
-	durationMap := map[string]int64{
-		MicrosecondsKey: microseconds,
-		MillisecondsKey: milliseconds,
-		SecondsKey:      seconds,
-		MinutesKey:      minutes,
-		HoursKey:        hours,
-		DaysKey:         days,
-		WeeksKey:        weeks,
-		YearsKey:        years,
+// truncatedComponents returns every displayed unit of the duration, dropping smaller units.
+func (d *Durafmt) truncatedComponents() []component {
+	values := d.convert()
+
+	if d.columns > 0 {
+		return d.columnComponents(values)
 	}
 
-	// Construct duration string.
-	for i := range units {
-		u := units[i]
-		v := durationMap[u]
-		strval := strconv.FormatInt(v, 10)
+	var components []component
+	for i := 0; i <= int(d.smallest()); i++ {
+		v := values[i]
 		switch {
-		// add to the duration string if v > 1.
-		case v > 1:
-			duration += strval + " " + u + " "
-		// remove the plural 's', if v is 1.
-		case v == 1:
-			duration += strval + " " + strings.TrimRight(u, "s") + " "
+		// add to the duration string if v > 0.
+		case v > 0:
+			components = append(components, component{unit: Unit(i), value: v})
 		// omit any value with 0s or 0.
-		case d.duration.String() == "0" || d.duration.String() == "0s":
+		case d.duration == 0:
 			pattern := fmt.Sprintf("^-?0%s$", unitsShort[i])
 			isMatch, err := regexp.MatchString(pattern, d.input)
 			if err != nil {
-				return ""
+				return nil
 			}
 			if isMatch {
-				duration += strval + " " + u
+				components = append(components, component{unit: Unit(i), value: v})
 			}
+		}
+	}
 
-		// omit any value with 0.
-		case v == 0:
-			continue
+	// drop units smaller than minUnit, falling back to zero minUnit.
+	if d.dropBelow {
+		kept := components[:0]
+		for _, c := range components {
+			if c.unit <= d.minUnit {
+				kept = append(kept, c)
+			}
+		}
+		components = kept
+		if len(components) == 0 {
+			components = append(components, component{unit: d.minUnit})
 		}
 	}
-	// trim any remaining spaces.
-	duration = strings.TrimSpace(duration)
 
-	// if more than 2 spaces present return the first 2 strings
-	// if short version is requested
-	if d.limitN > 0 {
-		parts := strings.Split(duration, " ")
-		if len(parts) > d.limitN*2 {
-			duration = strings.Join(parts[:d.limitN*2], " ")
+	// keep the biggest unit and its adjacent smaller unit in auto precision.
+	if d.autoPrecision && len(components) > 0 {
+		biggest := components[0].unit
+		n := 1
+		for n < len(components) && components[n].unit == biggest+1 {
+			n++
 		}
+		components = components[:n]
 	}
 
-	return duration
+	// return the first N components if short version is requested.
+	if d.limitN > 0 && len(components) > d.limitN {
+		components = components[:d.limitN]
+	}
+
+	return components
+}
+
+// columnComponents returns exactly d.columns units, zero values included,
+// starting from limitUnit or from the biggest non-zero unit.
+func (d *Durafmt) columnComponents(values []int64) []component {
+	n := int(d.smallest()) + 1
+	start := -1
+	for i := 0; i < n; i++ {
+		if (d.limitUnit > Years && Unit(i) == d.limitUnit) || (d.limitUnit == Years && values[i] != 0) {
+			start = i
+			break
+		}
+	}
+	if start < 0 || start+d.columns > n {
+		start = n - d.columns
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	var components []component
+	for i := start; i < n && i < start+d.columns; i++ {
+		components = append(components, component{unit: Unit(i), value: values[i]})
+	}
+
+	return components
+}
+
+// renderUnit formats a single component, e.g. "2 ч.".
+func (d *Durafmt) renderUnit(c component) string {
+	if d.unitRenderer != nil {
+		return d.unitRenderer(c.unit, c.value)
+	}
+
+	locale := d.Locale()
+	u, custom := d.abbreviations[c.unit]
+	switch {
+	// decline full words, fractions take the PluralOther form.
+	case d.words && c.fraction != "":
+		u = locale.word(c.unit, PluralOther, d.grammaticalCase)
+	case d.words:
+		u = locale.word(c.unit, locale.plural(c.value), d.grammaticalCase)
+	case custom:
+	case c.fraction != "":
+		u = locale.unit(c.unit, PluralOther)
+	default:
+		u = locale.unit(c.unit, locale.plural(c.value))
+	}
+	if d.columns > 0 {
+		u = fmt.Sprintf("%-*s", d.labelWidth(), u)
+	}
+	return d.pad(c) + d.unitSeparator() + u
+}
+
+// labelWidth returns the length in runes of the longest unit abbreviation, used for fixed columns.
+func (d *Durafmt) labelWidth() int {
+	width := 0
+	for i, u := range d.Locale().Units {
+		if abbr, ok := d.abbreviations[Unit(i)]; ok {
+			u = abbr
+		}
+		if n := utf8.RuneCountInString(u); n > width {
+			width = n
+		}
+	}
+	return width
+}
+
+// spell spells the number of c in words, agreeing with its unit.
+func (d *Durafmt) spell(c component) (string, bool) {
+	locale := d.Locale()
+	if !d.numberWords || c.fraction != "" || locale.NumberWords == nil {
+		return "", false
+	}
+	return locale.NumberWords(c.value, locale.gender(c.unit), d.grammaticalCase)
+}
+
+// unitSeparator returns the separator between a number and its unit.
+func (d *Durafmt) unitSeparator() string {
+	switch {
+	case d.unitSep != "":
+		return d.unitSep
+	case d.Locale().Layout.Tight:
+		return ""
+	}
+	return " "
+}
+
+// renderCompact formats a single component in the compact style, e.g. "2ч".
+func (d *Durafmt) renderCompact(c component) string {
+	number := d.formatNumber(c.value)
+	if d.numberRenderer != nil {
+		number = d.numberRenderer(c.unit, c.value)
+	}
+	return number + d.Locale().Compact[c.unit]
+}
+
+// pad formats the number of c, padded with spaces up to d.width runes.
+func (d *Durafmt) pad(c component) string {
+	number := d.formatNumber(c.value)
+	if spelled, ok := d.spell(c); ok {
+		number = spelled
+	}
+	if d.numberRenderer != nil {
+		number = d.numberRenderer(c.unit, c.value)
+	}
+	if c.fraction != "" {
+		number += d.Locale().DecimalSeparator + TransliterateDigits(c.fraction, d.Locale().Digits)
+	}
+	return fmt.Sprintf("%*s", d.width, number)
 }
@@ -2,6 +2,7 @@ package durafmt
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -440,3 +441,329 @@ func BenchmarkParseString(b *testing.B) {
 		ParseString(fmt.Sprintf("%dh", n))
 	}
 }
+
+func TestParseWithWidth(t *testing.T) {
+	testTimesWithWidth := []struct {
+		test     time.Duration
+		width    int
+		columns  int
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, 0, 0, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, 3, 0, "  2 ч.  30 мин."},
+		{-5 * time.Minute, 2, 0, "-5 мин."},
+		{-5 * time.Minute, 3, 0, " -5 мин."},
+		{-(2*time.Hour + 30*time.Minute), 3, 0, " -2 ч.  30 мин."},
+		{2*time.Hour + 30*time.Minute, 0, 3, "2 ч.   30 мин. 0 сек."},
+		{2*time.Hour + 30*time.Minute, 2, 2, " 2 ч.   30 мин."},
+		{5 * time.Microsecond, 0, 2, "0 млс. 5 мкс."},
+	}
+
+	for _, table := range testTimesWithWidth {
+		result := Parse(table.test).WithWidth(table.width).WithColumns(table.columns).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).String() = %q. got %q, expected %q",
+				table.test, result, result, table.expected)
+		}
+	}
+
+	result := Parse(5*time.Minute).LimitToUnit(HoursKey).WithColumns(2).String()
+	if expected := "0 ч.   5 мин."; result != expected {
+		t.Errorf("LimitToUnit(HoursKey).WithColumns(2) got %q, expected %q", result, expected)
+	}
+}
+
+func TestParseWithMaxLen(t *testing.T) {
+	testTimesWithMaxLen := []struct {
+		test     time.Duration
+		maxLen   int
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, 0, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, 12, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, 11, "2ч 30м"},
+		{2*time.Hour + 30*time.Minute, 5, "2 ч."},
+		{2*time.Hour + 30*time.Minute, 2, "2ч"},
+		{2*time.Hour + 30*time.Minute, 1, "2ч"},
+		{-90 * time.Second, 4, "-1м"},
+	}
+
+	for _, table := range testTimesWithMaxLen {
+		result := Parse(table.test).WithMaxLen(table.maxLen).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithMaxLen(%d).String() got %q, expected %q",
+				table.test, table.maxLen, result, table.expected)
+		}
+	}
+}
+
+func TestParseFitWidth(t *testing.T) {
+	testTimesFitWidth := []struct {
+		test     time.Duration
+		width    int
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute + 15*time.Second, 0, "2 ч. 30 мин. 15 сек."},
+		{2*time.Hour + 30*time.Minute + 15*time.Second, 20, "2 ч. 30 мин. 15 сек."},
+		{2*time.Hour + 30*time.Minute + 15*time.Second, 19, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute + 15*time.Second, 11, "2 ч."},
+		{2*time.Hour + 30*time.Minute + 15*time.Second, 2, "2 ч."},
+	}
+
+	for _, table := range testTimesFitWidth {
+		result := Parse(table.test).FitWidth(table.width).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).FitWidth(%d).String() got %q, expected %q",
+				table.test, table.width, result, table.expected)
+		}
+	}
+}
+
+func TestParseWithUnitSeparator(t *testing.T) {
+	testTimesWithUnitSep := []struct {
+		test     time.Duration
+		sep      string
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, "", "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, NBSP, "2\u00a0ч. 30\u00a0мин."},
+		{2*time.Hour + 30*time.Minute, NarrowNBSP, "2\u202fч. 30\u202fмин."},
+	}
+
+	for _, table := range testTimesWithUnitSep {
+		result := Parse(table.test).WithUnitSeparator(table.sep).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithUnitSeparator(%q).String() got %q, expected %q",
+				table.test, table.sep, result, table.expected)
+		}
+	}
+}
+
+func TestParseWithUnitRenderer(t *testing.T) {
+	render := func(u Unit, v int64) string {
+		if u == Hours {
+			return fmt.Sprintf("<b>%d</b>h", v)
+		}
+		return fmt.Sprintf("%d%s", v, u)
+	}
+
+	result := Parse(-(2*time.Hour + 30*time.Minute)).WithUnitRenderer(render).String()
+	if expected := "-<b>2</b>h 30minutes"; result != expected {
+		t.Errorf("WithUnitRenderer().String() got %q, expected %q", result, expected)
+	}
+
+	result = Parse(2 * time.Hour).WithUnitRenderer(render).WithUnitRenderer(nil).String()
+	if expected := "2 ч."; result != expected {
+		t.Errorf("WithUnitRenderer(nil).String() got %q, expected %q", result, expected)
+	}
+}
+
+func TestParts(t *testing.T) {
+	testTimesParts := []struct {
+		test     time.Duration
+		expected []string
+	}{
+		{2*time.Hour + 30*time.Minute, []string{"2 ч.", "30 мин."}},
+		{-(2*time.Hour + 30*time.Minute), []string{"-2 ч.", "30 мин."}},
+		{time.Second, []string{"1 сек."}},
+	}
+
+	for _, table := range testTimesParts {
+		result := Parse(table.test).Parts()
+		if fmt.Sprint(result) != fmt.Sprint(table.expected) {
+			t.Errorf("Parse(%q).Parts() got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+
+	result := Parse(2*time.Hour + 30*time.Minute).LimitFirstN(1).WithCase(Upper).Parts()
+	if expected := []string{"2 Ч."}; fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("LimitFirstN(1).WithCase(Upper).Parts() got %q, expected %q", result, expected)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	testTimesWriteTo := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(2*time.Hour + 30*time.Minute), "2 ч. 30 мин."},
+		{Parse(-(2*time.Hour + 30*time.Minute)).WithCase(Title), "-2 Ч. 30 Мин."},
+		{Parse(2*time.Hour + 30*time.Minute).WithMaxLen(6), "2ч 30м"},
+		{Parse(2*time.Hour + 30*time.Minute).WithColumns(3), "2 ч.   30 мин. 0 сек."},
+	}
+
+	for _, table := range testTimesWriteTo {
+		var b strings.Builder
+		n, err := table.test.WriteTo(&b)
+		if err != nil {
+			t.Errorf("WriteTo() error %q", err)
+		}
+		if b.String() != table.expected || n != int64(len(table.expected)) {
+			t.Errorf("WriteTo() got %q (%d bytes), expected %q", b.String(), n, table.expected)
+		}
+		if result := table.test.String(); result != b.String() {
+			t.Errorf("WriteTo() got %q, String() got %q", b.String(), result)
+		}
+	}
+}
+
+func TestCopyOnWrite(t *testing.T) {
+	base := Parse(2*time.Hour + 30*time.Minute)
+	short := base.LimitFirstN(1)
+	english := base.WithLocale(English).LimitToUnit(MinutesKey)
+
+	testDurafmts := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{base, "2 ч. 30 мин."},
+		{short, "2 ч."},
+		{english, "150 minutes"},
+	}
+
+	for _, table := range testDurafmts {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
+
+func TestCachedString(t *testing.T) {
+	base := Parse(2*time.Hour + 30*time.Minute)
+	if result := base.String(); result != "2 ч. 30 мин." {
+		t.Errorf("String() got %q, expected %q", result, "2 ч. 30 мин.")
+	}
+
+	// specializing a cached value must not reuse its output.
+	done := make(chan string)
+	for i := 1; i <= 2; i++ {
+		go func(n int) { done <- base.LimitFirstN(n).String() }(i)
+	}
+	results := map[string]bool{<-done: true, <-done: true}
+	if !results["2 ч."] || !results["2 ч. 30 мин."] {
+		t.Errorf("LimitFirstN() got %v, expected %q and %q", results, "2 ч.", "2 ч. 30 мин.")
+	}
+}
+
+func TestEqualCompare(t *testing.T) {
+	testDurafmts := []struct {
+		a, b    *Durafmt
+		equal   bool
+		compare int
+	}{
+		{Parse(time.Hour), Parse(time.Hour), true, 0},
+		{Parse(time.Hour), Parse(time.Hour).Clone(), true, 0},
+		{Parse(time.Hour), Parse(time.Hour).WithLocale(English), false, 0},
+		{Parse(time.Hour), Parse(time.Minute), false, 1},
+		{Parse(-time.Hour), Parse(time.Minute), false, -1},
+		{nil, Parse(time.Minute), false, -1},
+		{nil, nil, true, 0},
+	}
+
+	for _, table := range testDurafmts {
+		if result := table.a.Equal(table.b); result != table.equal {
+			t.Errorf("%v.Equal(%v) got %v, expected %v", table.a, table.b, result, table.equal)
+		}
+		if result := table.a.Compare(table.b); result != table.compare {
+			t.Errorf("%v.Compare(%v) got %d, expected %d", table.a, table.b, result, table.compare)
+		}
+	}
+}
+
+func TestParseWithAutoPrecision(t *testing.T) {
+	testTimesWithAuto := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{1234 * time.Microsecond, "1 млс. 234 мкс."},
+		{500 * time.Microsecond, "500 мкс."},
+		{12*time.Second + 345*time.Millisecond + 6*time.Microsecond, "12 сек. 345 млс."},
+		{5*time.Minute + 30*time.Second + 345*time.Millisecond, "5 мин. 30 сек."},
+		{time.Hour + 5*time.Second, "1 ч."},
+		{26*time.Hour + 5*time.Minute, "1 дн. 2 ч."},
+		{-(26*time.Hour + 5*time.Minute), "-1 дн. 2 ч."},
+	}
+
+	for _, table := range testTimesWithAuto {
+		result := Parse(table.test).WithAutoPrecision().String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithAutoPrecision().String() got %q, expected %q",
+				table.test, result, table.expected)
+		}
+	}
+}
+
+func TestParseDropBelow(t *testing.T) {
+	testTimesDropBelow := []struct {
+		test     time.Duration
+		unit     Unit
+		limitN   int
+		expected string
+	}{
+		{12*time.Second + 345*time.Millisecond, Seconds, 0, "12 сек."},
+		{time.Hour + 12*time.Second + 345*time.Millisecond, Minutes, 0, "1 ч."},
+		{time.Hour + 12*time.Second + 345*time.Millisecond, Seconds, 1, "1 ч."},
+		{time.Hour + 2*time.Minute + 12*time.Second, Seconds, 2, "1 ч. 2 мин."},
+		{345 * time.Millisecond, Seconds, 0, "0 сек."},
+		{-345 * time.Millisecond, Seconds, 0, "-0 сек."},
+		{345 * time.Millisecond, Microseconds, 0, "345 млс."},
+	}
+
+	for _, table := range testTimesDropBelow {
+		result := Parse(table.test).DropBelow(table.unit).LimitFirstN(table.limitN).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).DropBelow(%s).String() got %q, expected %q",
+				table.test, table.unit, result, table.expected)
+		}
+	}
+}
+
+func TestParseWithAbbreviations(t *testing.T) {
+	testTimesWithAbbr := []struct {
+		test     time.Duration
+		abbrs    map[Unit]string
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, nil, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, map[Unit]string{Hours: "час", Minutes: "мин"}, "2 час 30 мин"},
+		{time.Hour + time.Second, map[Unit]string{Hours: "h", Minutes: "m"}, "1 h 1 сек."},
+	}
+
+	for _, table := range testTimesWithAbbr {
+		result := Parse(table.test).WithAbbreviations(table.abbrs).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithAbbreviations(%v).String() got %q, expected %q",
+				table.test, table.abbrs, result, table.expected)
+		}
+	}
+
+	result := Parse(time.Second + time.Millisecond).WithLocale(English).WithAbbreviations(map[Unit]string{Seconds: "s", Milliseconds: "ms"}).String()
+	if expected := "1 s 1 ms"; result != expected {
+		t.Errorf("WithAbbreviations() got %q, expected %q", result, expected)
+	}
+
+	result = Parse(time.Hour).WithAbbreviations(map[Unit]string{Hours: "h", Minutes: "m"}).WithColumns(2).String()
+	if expected := "1 h    0 m"; result != expected {
+		t.Errorf("WithAbbreviations().WithColumns(2) got %q, expected %q", result, expected)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	duration := 354*time.Hour + 22*time.Minute + 3*time.Second
+
+	testFormat := []struct {
+		test     string
+		expected string
+	}{
+		{Format(duration), "2 нед. 18 ч. 22 мин. 3 сек."},
+		{FormatShort(duration), "2 нед."},
+		{FormatN(duration, 2), "2 нед. 18 ч."},
+		{FormatN(duration, 0), "2 нед. 18 ч. 22 мин. 3 сек."},
+	}
+
+	for _, table := range testFormat {
+		if table.test != table.expected {
+			t.Errorf("Format() got %q, expected %q", table.test, table.expected)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package durafmtcron describes the time until the next run of a cron schedule,
+// e.g. "следующий запуск через 3 ч. 12 мин.".
+package durafmtcron
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/robfig/cron/v3"
+)
+
+// Phrases holds the fmt patterns of the next run by locale name.
+// A locale missing from it uses the pattern of its parent tag, e.g. "en-GB" → "en",
+// and the Russian pattern at last.
+var Phrases = map[string]string{
+	"ru": "следующий запуск через %s",
+	"en": "next run in %s",
+	"uk": "наступний запуск через %s",
+}
+
+// Until returns the time from now until the next run of the schedule,
+// nil if the schedule never runs, e.g. "0 0 30 2 *".
+func Until(schedule cron.Schedule, now time.Time) *durafmt.Durafmt {
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return durafmt.Between(now, next)
+}
+
+// Next parses the standard cron spec, "*/15 * * * *" or "@hourly", and returns a phrase
+// about the time from now until its next run. The two biggest units are shown
+// unless the options say otherwise.
+func Next(spec string, now time.Time, opts ...durafmt.Option) (string, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return "", err
+	}
+	until := Until(schedule, now)
+	if until == nil {
+		return "", errors.New("durafmtcron: " + spec + " never runs")
+	}
+	d := until.LimitFirstN(2).Apply(opts...)
+	return fmt.Sprintf(phrase(d.Locale().Name), d), nil
+}
+
+// phrase returns the pattern of Phrases for the locale name, walking up its parent tags.
+func phrase(name string) string {
+	for tag := name; tag != ""; {
+		if p, ok := Phrases[tag]; ok {
+			return p
+		}
+		i := strings.LastIndexAny(tag, "-_")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return Phrases["ru"]
+}
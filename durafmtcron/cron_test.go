@@ -0,0 +1,40 @@
+package durafmtcron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+)
+
+func TestNext(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 48, 30, 0, time.UTC)
+	english := durafmt.InLocale(durafmt.English)
+	british := durafmt.English
+	british.Name = "en-GB"
+
+	testNext := []struct {
+		spec     string
+		opts     []durafmt.Option
+		expected string
+	}{
+		{"0 16 * * *", nil, "следующий запуск через 3 ч. 11 мин."},
+		{"*/15 * * * *", nil, "следующий запуск через 11 мин. 30 сек."},
+		{"@daily", []durafmt.Option{english}, "next run in 11 hours 11 minutes"},
+		{"@daily", []durafmt.Option{durafmt.InLocale(british)}, "next run in 11 hours 11 minutes"},
+		{"@daily", []durafmt.Option{durafmt.InLocale(durafmt.Ukrainian)}, "наступний запуск через 11 год. 11 хв."},
+	}
+
+	for _, table := range testNext {
+		result, err := Next(table.spec, now, table.opts...)
+		if err != nil || result != table.expected {
+			t.Errorf("Next(%q) got %q, %v, expected %q", table.spec, result, err, table.expected)
+		}
+	}
+
+	for _, spec := range []string{"61 * * * *", "0 0 30 2 *"} {
+		if result, err := Next(spec, now); err == nil {
+			t.Errorf("Next(%q) got %q, expected an error", spec, result)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/ihippik/durafmt/durafmtcron
+
+go 1.14
+
+require (
+	github.com/ihippik/durafmt v0.0.0
+	github.com/robfig/cron/v3 v3.0.1
+)
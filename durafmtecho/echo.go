@@ -0,0 +1,64 @@
+// Package durafmtecho reports the latency of Echo requests as a human readable duration.
+package durafmtecho
+
+import (
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/labstack/echo/v4"
+)
+
+// Config is the output format of the Middleware.
+type Config struct {
+	// Style is the name of a registered durafmt style, "" means the default format.
+	Style string
+	// Options set the output format of the latency.
+	Options []durafmt.Option
+	// Header is the response header receiving the latency until the response is written,
+	// e.g. "X-Latency" for debugging. "" means no header.
+	Header string
+	// Log writes "GET /users 200 1,5 сек." with the logger of the request.
+	Log bool
+}
+
+// LatencyKey is the key of the human readable latency in the echo.Context, so it can be
+// added to the values of the request logger middleware placed before this one:
+//
+//	LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+//		log.Printf("%s %v", v.URI, c.Get(durafmtecho.LatencyKey))
+//		return nil
+//	},
+const LatencyKey = "durafmt.latency"
+
+// Middleware returns a middleware measuring the latency of every request.
+func Middleware(cfg Config) echo.MiddlewareFunc {
+	format := func(latency time.Duration) string {
+		return durafmt.Parse(latency).WithStyle(cfg.Style).Apply(cfg.Options...).String()
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if cfg.Header != "" {
+				res := c.Response()
+				res.Before(func() {
+					res.Header().Set(cfg.Header, format(time.Since(start)))
+				})
+			}
+
+			err := next(c)
+			if err != nil {
+				// let the error handler write the response, so the status is known,
+				// like the echo Logger middleware does.
+				c.Error(err)
+			}
+
+			human := format(time.Since(start))
+			c.Set(LatencyKey, human)
+			if cfg.Log {
+				c.Logger().Infof("%s %s %d %s", c.Request().Method, c.Request().URL.Path, c.Response().Status, human)
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package durafmtecho
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/labstack/echo/v4"
+)
+
+func TestMiddleware(t *testing.T) {
+	var latency interface{}
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+			latency = c.Get(LatencyKey)
+			return err
+		}
+	})
+	e.Use(Middleware(Config{
+		Header:  "X-Latency",
+		Options: []durafmt.Option{func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.DropBelow(durafmt.Hours) }},
+	}))
+	e.GET("/", func(c echo.Context) error {
+		time.Sleep(time.Millisecond)
+		return c.String(http.StatusOK, "ok")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if result := rec.Header().Get("X-Latency"); result != "0 ч." {
+		t.Errorf("X-Latency got %q, expected %q", result, "0 ч.")
+	}
+	if latency != "0 ч." {
+		t.Errorf("LatencyKey got %v, expected %q", latency, "0 ч.")
+	}
+}
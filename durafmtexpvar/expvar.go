@@ -0,0 +1,67 @@
+// Package durafmtexpvar publishes durations through expvar with their human readable form.
+// It lives apart from durafmt because importing expvar registers the /debug/vars handler.
+package durafmtexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"sync/atomic"
+	"time"
+
+	"github.com/ihippik/durafmt"
+)
+
+// Var is an expvar.Var holding a duration, published as JSON with both the nanoseconds
+// and the human readable string, e.g. {"ns":5400000000000,"human":"1 ч. 30 мин."}.
+// Its methods are safe for concurrent use.
+type Var struct {
+	ns   int64
+	opts []durafmt.Option
+}
+
+// NewVar creates a new Var formatted with the options and publishes it under the name,
+// like expvar.NewInt. It panics if the name is already registered.
+func NewVar(name string, opts ...durafmt.Option) *Var {
+	v := &Var{opts: opts}
+	expvar.Publish(name, v)
+	return v
+}
+
+// Set sets the duration of v.
+func (v *Var) Set(d time.Duration) {
+	atomic.StoreInt64(&v.ns, int64(d))
+}
+
+// Add adds delta to the duration of v.
+func (v *Var) Add(delta time.Duration) {
+	atomic.AddInt64(&v.ns, int64(delta))
+}
+
+// Value returns the duration of v.
+func (v *Var) Value() time.Duration {
+	return time.Duration(atomic.LoadInt64(&v.ns))
+}
+
+// String returns the JSON value of v, it implements expvar.Var.
+func (v *Var) String() string {
+	return varJSON(durafmt.Parse(v.Value()).Apply(v.opts...))
+}
+
+// VarFunc is an expvar.Var computing the duration on every read, e.g. the process uptime:
+//
+//	expvar.Publish("uptime", durafmtexpvar.VarFunc(func() time.Duration { return time.Since(start) }))
+type VarFunc func() time.Duration
+
+// String returns the JSON value of the duration, it implements expvar.Var.
+func (f VarFunc) String() string {
+	return varJSON(durafmt.Parse(f()))
+}
+
+// varJSON encodes d as the JSON value of a Var.
+func varJSON(d *durafmt.Durafmt) string {
+	data, _ := json.Marshal(struct {
+		Nanoseconds int64  `json:"ns"`
+		Human       string `json:"human"`
+	}{int64(d.Duration()), d.String()})
+	return string(data)
+}
@@ -0,0 +1,25 @@
+package durafmtexpvar
+
+import (
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+)
+
+func TestVar(t *testing.T) {
+	v := NewVar("durafmt_test_var", durafmt.InLocale(durafmt.English))
+	v.Set(time.Hour)
+	v.Add(30 * time.Minute)
+
+	expected := `{"ns":5400000000000,"human":"1 hour 30 minutes"}`
+	if result := expvar.Get("durafmt_test_var").String(); result != expected {
+		t.Errorf("Var.String() got %s, expected %s", result, expected)
+	}
+
+	f := VarFunc(func() time.Duration { return 2 * time.Second })
+	if result, expected := f.String(), `{"ns":2000000000,"human":"2 сек."}`; result != expected {
+		t.Errorf("VarFunc.String() got %s, expected %s", result, expected)
+	}
+}
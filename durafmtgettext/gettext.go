@@ -0,0 +1,326 @@
+// Package durafmtgettext sources the durafmt unit names and phrases from gettext catalogs,
+// so durations are translated with the .po and .mo files of existing translation workflows.
+//
+// The messages are identified by their English text, as in the English locale:
+// the nouns are plural messages such as msgid "hour" / msgid_plural "hours", the phrases
+// are messages such as "%s left" or "%s ago". The abbreviations use the contexts
+// "unit", "compact" and "single" with the English abbreviation as msgid, e.g. msgctxt "compact" msgid "h".
+package durafmtgettext
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/ihippik/durafmt"
+)
+
+// contextSeparator separates the context from the msgid in catalog keys, as in .mo files.
+const contextSeparator = "\x04"
+
+// categories maps the index of a gettext plural form to a plural category,
+// the last form of a catalog being PluralOther.
+var categories = []durafmt.PluralCategory{durafmt.PluralOne, durafmt.PluralFew, durafmt.PluralMany, durafmt.PluralTwo, durafmt.PluralZero}
+
+// Catalog holds the translations of a gettext catalog.
+type Catalog struct {
+	// Language is the language of the catalog from its header, e.g. "de".
+	Language string
+
+	messages map[string][]string // Translations by context and msgid.
+	nplurals int
+	plural   pluralExpr
+}
+
+// ParsePO parses a .po catalog. Fuzzy and untranslated messages are ignored.
+func ParsePO(r io.Reader) (*Catalog, error) {
+	c := &Catalog{messages: make(map[string][]string)}
+	var (
+		ctxt, id string
+		strs     []string
+		fuzzy    bool
+		field    *string
+		line     int
+	)
+	flush := func() {
+		if len(strs) > 0 {
+			c.add(ctxt, id, strs, fuzzy)
+		}
+		ctxt, id, strs, fuzzy, field = "", "", nil, false, nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		keyword := s
+		if i := strings.IndexAny(s, " \t"); i >= 0 {
+			keyword = s[:i]
+		}
+		switch {
+		case s == "":
+		case strings.HasPrefix(s, "#"):
+			if len(strs) > 0 {
+				flush()
+			}
+			if strings.HasPrefix(s, "#,") && strings.Contains(s, "fuzzy") {
+				fuzzy = true
+			}
+		case strings.HasPrefix(s, `"`):
+			if field == nil {
+				return nil, fmt.Errorf("durafmtgettext: line %d: string outside a message", line)
+			}
+			v, err := strconv.Unquote(s)
+			if err != nil {
+				return nil, fmt.Errorf("durafmtgettext: line %d: %v", line, err)
+			}
+			*field += v
+		case keyword == "msgctxt", keyword == "msgid", keyword == "msgid_plural", strings.HasPrefix(keyword, "msgstr"):
+			v, err := strconv.Unquote(strings.TrimSpace(s[len(keyword):]))
+			if err != nil {
+				return nil, fmt.Errorf("durafmtgettext: line %d: %v", line, err)
+			}
+			if (keyword == "msgctxt" || keyword == "msgid") && len(strs) > 0 {
+				flush()
+			}
+			switch {
+			case keyword == "msgctxt":
+				ctxt, field = v, &ctxt
+			case keyword == "msgid":
+				id, field = v, &id
+			case keyword == "msgid_plural":
+				field = new(string)
+			default:
+				strs = append(strs, v)
+				field = &strs[len(strs)-1]
+			}
+		default:
+			return nil, fmt.Errorf("durafmtgettext: line %d: unexpected %q", line, keyword)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return c, c.parseHeader()
+}
+
+// ParseMO parses a binary .mo catalog.
+func ParseMO(r io.Reader) (*Catalog, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 20 {
+		return nil, errors.New("durafmtgettext: .mo file too short")
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(data) {
+	case 0x950412de:
+		order = binary.LittleEndian
+	case 0xde120495:
+		order = binary.BigEndian
+	default:
+		return nil, errors.New("durafmtgettext: not a .mo file")
+	}
+
+	str := func(table uint32, i uint32) (string, error) {
+		at := uint64(table) + uint64(i)*8
+		if at+8 > uint64(len(data)) {
+			return "", errors.New("durafmtgettext: .mo table out of range")
+		}
+		length, offset := uint64(order.Uint32(data[at:])), uint64(order.Uint32(data[at+4:]))
+		if offset+length > uint64(len(data)) {
+			return "", errors.New("durafmtgettext: .mo string out of range")
+		}
+		return string(data[offset : offset+length]), nil
+	}
+
+	c := &Catalog{messages: make(map[string][]string)}
+	n, originals, translations := order.Uint32(data[8:]), order.Uint32(data[12:]), order.Uint32(data[16:])
+	for i := uint32(0); i < n; i++ {
+		key, err := str(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		translation, err := str(translations, i)
+		if err != nil {
+			return nil, err
+		}
+		// the msgid_plural follows the msgid after a NUL.
+		if j := strings.IndexByte(key, 0); j >= 0 {
+			key = key[:j]
+		}
+		c.messages[key] = strings.Split(translation, "\x00")
+	}
+	return c, c.parseHeader()
+}
+
+// add adds a message, unless it is fuzzy or untranslated.
+func (c *Catalog) add(ctxt, id string, strs []string, fuzzy bool) {
+	if fuzzy && id != "" {
+		return
+	}
+	for _, s := range strs {
+		if s == "" {
+			return
+		}
+	}
+	key := id
+	if ctxt != "" {
+		key = ctxt + contextSeparator + id
+	}
+	c.messages[key] = strs
+}
+
+// parseHeader reads the language and the plural forms from the header message.
+func (c *Catalog) parseHeader() error {
+	header := c.messages[""]
+	if len(header) == 0 {
+		return errors.New("durafmtgettext: no header")
+	}
+	for _, line := range strings.Split(header[0], "\n") {
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+		value := strings.TrimSpace(line[i+1:])
+		switch strings.TrimSpace(line[:i]) {
+		case "Language":
+			c.Language = strings.Replace(value, "_", "-", -1)
+		case "Plural-Forms":
+			nplurals, plural, err := parsePluralForms(value)
+			if err != nil {
+				return fmt.Errorf("durafmtgettext: %v", err)
+			}
+			if nplurals > len(categories)+1 {
+				return fmt.Errorf("durafmtgettext: %d plural forms, at most %d supported", nplurals, len(categories)+1)
+			}
+			c.nplurals, c.plural = nplurals, plural
+		}
+	}
+	if c.plural == nil {
+		c.nplurals, c.plural = 2, func(n int64) int64 { return boolInt(n != 1) }
+	}
+	return nil
+}
+
+// get returns the translation of the msgid in the context, "" if it has none.
+func (c *Catalog) get(ctxt, id string) string {
+	key := id
+	if ctxt != "" {
+		key = ctxt + contextSeparator + id
+	}
+	if strs := c.messages[key]; len(strs) > 0 {
+		return strs[0]
+	}
+	return ""
+}
+
+// category returns the plural category of the form index i.
+func (c *Catalog) category(i int64) durafmt.PluralCategory {
+	if i < 0 || i >= int64(c.nplurals-1) {
+		return durafmt.PluralOther
+	}
+	return categories[i]
+}
+
+// Locale returns the parent locale with the translations of the catalog, named after its language.
+// The untranslated unit names and phrases are those of the parent. The nouns use the plural forms
+// of the catalog once one of them is translated, every noun then needs all its forms.
+func (c *Catalog) Locale(parent durafmt.Locale) (durafmt.Locale, error) {
+	l := parent
+	if c.Language != "" {
+		l.Name = c.Language
+	}
+
+	l.Units = c.translateAll("unit", durafmt.English.Units, parent.Units)
+	l.Compact = c.translateAll("compact", durafmt.English.Compact, parent.Compact)
+	l.SingleUnits = c.translateAll("single", durafmt.English.SingleUnits, parent.SingleUnits)
+	for _, field := range []struct {
+		dst     *string
+		english string
+	}{
+		{&l.Remaining, durafmt.English.Remaining},
+		{&l.Overdue, durafmt.English.Overdue},
+		{&l.MoreThan, durafmt.English.MoreThan},
+		{&l.LessThan, durafmt.English.LessThan},
+		{&l.Respectively, durafmt.English.Respectively},
+		{&l.ListAnd, durafmt.English.ListAnd},
+		{&l.Segments, durafmt.English.Segments},
+		{&l.SegmentsRest, durafmt.English.SegmentsRest},
+		{&l.Docker.LessThanASecond, durafmt.English.Docker.LessThanASecond},
+		{&l.Docker.AboutAMinute, durafmt.English.Docker.AboutAMinute},
+		{&l.Docker.AboutAnHour, durafmt.English.Docker.AboutAnHour},
+		{&l.Docker.Months, durafmt.English.Docker.Months},
+		{&l.Docker.Ago, durafmt.English.Docker.Ago},
+	} {
+		if s := c.get("", field.english); s != "" {
+			*field.dst = s
+		}
+	}
+
+	nouns := append(append([]durafmt.UnitForms(nil), durafmt.English.Words...), durafmt.English.Interval)
+	words := make([]durafmt.UnitForms, len(nouns))
+	for i, english := range nouns {
+		words[i] = c.forms(english)
+	}
+	if !translated(words) {
+		return l, nil
+	}
+	for i, forms := range words {
+		if len(forms) < c.nplurals {
+			return durafmt.Locale{}, fmt.Errorf("durafmtgettext: %q has %d of %d plural forms",
+				nouns[i].Form(durafmt.PluralOne), len(forms), c.nplurals)
+		}
+	}
+	l.Words, l.Interval = words[:len(words)-1], words[len(words)-1]
+	l.Declensions = nil
+	l.Plural = func(n int64) durafmt.PluralCategory {
+		if n < 0 {
+			n = -n
+		}
+		return c.category(c.plural(n))
+	}
+	return l, nil
+}
+
+// forms returns the translated forms of the English noun by category.
+func (c *Catalog) forms(english durafmt.UnitForms) durafmt.UnitForms {
+	strs := c.messages[english.Form(durafmt.PluralOne)]
+	if len(strs) == 0 {
+		return nil
+	}
+	forms := make(durafmt.UnitForms, len(strs))
+	for i, s := range strs {
+		forms[c.category(int64(i))] = s
+	}
+	return forms
+}
+
+// translateAll returns the translations of the English names in the context,
+// the parent names when one of them is untranslated.
+func (c *Catalog) translateAll(ctxt string, english, parent []string) []string {
+	names := make([]string, len(english))
+	for i, id := range english {
+		if names[i] = c.get(ctxt, id); names[i] == "" {
+			return parent
+		}
+	}
+	return names
+}
+
+// translated reports whether any of the forms is translated.
+func translated(forms []durafmt.UnitForms) bool {
+	for _, f := range forms {
+		if f != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+package durafmtgettext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+)
+
+const germanPO = `# German translations of durafmt.
+msgid ""
+msgstr ""
+"Language: de_DE\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+msgid "year"
+msgid_plural "years"
+msgstr[0] "Jahr"
+msgstr[1] "Jahre"
+
+msgid "week"
+msgid_plural "weeks"
+msgstr[0] "Woche"
+msgstr[1] "Wochen"
+
+msgid "day"
+msgid_plural "days"
+msgstr[0] "Tag"
+msgstr[1] "Tage"
+
+msgid "hour"
+msgid_plural "hours"
+msgstr[0] "Stunde"
+msgstr[1] "Stunden"
+
+msgid "minute"
+msgid_plural "minutes"
+msgstr[0] "Minute"
+msgstr[1] "Minuten"
+
+msgid "second"
+msgid_plural "seconds"
+msgstr[0] "Sekunde"
+msgstr[1] "Sekunden"
+
+msgid "millisecond"
+msgid_plural "milliseconds"
+msgstr[0] "Millisekunde"
+msgstr[1] "Millisekunden"
+
+msgid "microsecond"
+msgid_plural "microseconds"
+msgstr[0] "Mikrosekunde"
+msgstr[1] "Mikrosekunden"
+
+msgid "nanosecond"
+msgid_plural "nanoseconds"
+msgstr[0] "Nanosekunde"
+msgstr[1] "Nanosekunden"
+
+msgid "interval"
+msgid_plural "intervals"
+msgstr[0] "Intervall"
+msgstr[1] "Intervalle"
+
+msgid "%s left"
+msgstr "noch "
+"%s"
+
+#, fuzzy
+msgid "overdue by %s"
+msgstr "überfällig %s"
+
+msgid "%s ago"
+msgstr "vor %s"
+
+msgctxt "compact"
+msgid "h"
+msgstr "Std"
+`
+
+func TestParsePO(t *testing.T) {
+	c, err := ParsePO(strings.NewReader(germanPO))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := c.Locale(durafmt.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testGerman := []struct {
+		result   string
+		expected string
+	}{
+		{l.Name, "de-DE"},
+		{durafmt.Parse(time.Hour + 2*time.Minute).WithLocale(l).Accessible().String(), "1 Stunde 2 Minuten"},
+		{durafmt.Remaining(time.Now().Add(2*time.Hour+time.Second), func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.WithLocale(l).LimitFirstN(1).Accessible() }), "noch 2 Stunden"},
+		{l.Overdue, "overdue by %s"},
+		{l.Docker.Ago, "vor %s"},
+		{l.Compact[durafmt.Hours], "h"},
+		{l.Interval.Form(l.Plural(3)), "Intervalle"},
+	}
+	for _, table := range testGerman {
+		if table.result != table.expected {
+			t.Errorf("ParsePO() got %q, expected %q", table.result, table.expected)
+		}
+	}
+}
+
+func TestParseMO(t *testing.T) {
+	messages := map[string]string{
+		"":                  "Language: ru\nPlural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n",
+		"unit\x04hours":     "час.",
+		"more than %s":      "свыше %s",
+		"hour\x00hours":     "час\x00часа\x00часов",
+		"interval\x00x":     "интервал\x00интервала\x00интервалов",
+		"minute\x00minutes": "минута\x00минуты\x00минут",
+	}
+	for _, noun := range []string{"year", "week", "day", "second", "millisecond", "microsecond", "nanosecond"} {
+		messages[noun+"\x00"+noun+"s"] = noun + "\x00" + noun + "\x00" + noun
+	}
+	c, err := ParseMO(bytes.NewReader(encodeMO(messages)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := c.Locale(durafmt.Russian)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testRussian := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{21*time.Hour + 2*time.Minute, "21 час 2 минуты"},
+		{11*time.Hour + 5*time.Minute, "11 часов 5 минут"},
+		{3 * time.Hour, "3 часа"},
+	}
+	for _, table := range testRussian {
+		if result := durafmt.Parse(table.test).WithLocale(l).Accessible().String(); result != table.expected {
+			t.Errorf("ParseMO() got %q, expected %q", result, table.expected)
+		}
+	}
+	if l.MoreThan != "свыше %s" || l.Units[durafmt.Hours] != "ч." {
+		t.Errorf("ParseMO() got %q and %q, expected the phrase translated and the partial units kept", l.MoreThan, l.Units)
+	}
+}
+
+func TestPluralForms(t *testing.T) {
+	testPluralForms := []struct {
+		header   string
+		n        []int64
+		expected []int64
+	}{
+		{"nplurals=1; plural=0;", []int64{0, 1, 5}, []int64{0, 0, 0}},
+		{"nplurals=2; plural=n>1;", []int64{0, 1, 2}, []int64{0, 0, 1}},
+		{"nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;", []int64{1, 3, 5}, []int64{0, 1, 2}},
+		{"nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);", []int64{0, 1, 2, 103, 11, 100}, []int64{0, 1, 2, 3, 4, 5}},
+		{"nplurals=2; plural=!(n/2*2 == n) + 0 * (n - n);", []int64{4, 5}, []int64{0, 1}},
+	}
+
+	for _, table := range testPluralForms {
+		_, plural, err := parsePluralForms(table.header)
+		if err != nil {
+			t.Errorf("parsePluralForms(%q) failed: %v", table.header, err)
+			continue
+		}
+		for i, n := range table.n {
+			if result := plural(n); result != table.expected[i] {
+				t.Errorf("parsePluralForms(%q)(%d) got %d, expected %d", table.header, n, result, table.expected[i])
+			}
+		}
+	}
+
+	for _, header := range []string{"nplurals=2;", "plural=n;", "nplurals=2; plural=(n;", "nplurals=2; plural=n ? 1;", "nplurals=x; plural=n;"} {
+		if _, _, err := parsePluralForms(header); err == nil {
+			t.Errorf("parsePluralForms(%q) expected an error", header)
+		}
+	}
+}
+
+// encodeMO encodes the messages as a little-endian .mo file.
+func encodeMO(messages map[string]string) []byte {
+	keys := make([]string, 0, len(messages))
+	for k := range messages {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	n := uint32(len(keys))
+	originals, translations := uint32(28), 28+8*n
+	offset := translations + 8*n
+	var tables, strs bytes.Buffer
+	for _, table := range []func(string) string{
+		func(k string) string { return k },
+		func(k string) string { return messages[k] },
+	} {
+		for _, k := range keys {
+			s := table(k)
+			binary.Write(&tables, binary.LittleEndian, []uint32{uint32(len(s)), offset + uint32(strs.Len())})
+			strs.WriteString(s + "\x00")
+		}
+	}
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, []uint32{0x950412de, 0, n, originals, translations, 0, 0})
+	b.Write(tables.Bytes())
+	b.Write(strs.Bytes())
+	return b.Bytes()
+}
@@ -0,0 +1,228 @@
+package durafmtgettext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pluralExpr is a compiled gettext plural expression, returning the index of the form of n.
+type pluralExpr func(n int64) int64
+
+// parsePluralForms parses a Plural-Forms header, e.g. "nplurals=2; plural=(n != 1);".
+func parsePluralForms(header string) (int, pluralExpr, error) {
+	nplurals, expr := 0, ""
+	for _, field := range strings.Split(header, ";") {
+		i := strings.IndexByte(field, '=')
+		if i < 0 {
+			continue
+		}
+		switch strings.TrimSpace(field[:i]) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(field[i+1:]))
+			if err != nil || n < 1 {
+				return 0, nil, fmt.Errorf("invalid nplurals %q", field[i+1:])
+			}
+			nplurals = n
+		case "plural":
+			expr = field[i+1:]
+		}
+	}
+	if nplurals == 0 || expr == "" {
+		return 0, nil, fmt.Errorf("invalid Plural-Forms %q", header)
+	}
+
+	p := exprParser{tokens: tokenizeExpr(expr)}
+	plural, err := p.ternary()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("plural %q: %v", expr, err)
+	}
+	return nplurals, plural, nil
+}
+
+// exprParser parses the C expression of a plural form, by precedence climbing.
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+// binaryLevels are the binary operators by increasing precedence.
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) ternary() (pluralExpr, error) {
+	cond, err := p.binary(0)
+	if err != nil || p.peek() != "?" {
+		return cond, err
+	}
+	p.pos++
+	then, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != ":" {
+		return nil, fmt.Errorf("expected :, got %q", p.peek())
+	}
+	p.pos++
+	otherwise, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	return func(n int64) int64 {
+		if cond(n) != 0 {
+			return then(n)
+		}
+		return otherwise(n)
+	}, nil
+}
+
+func (p *exprParser) binary(level int) (pluralExpr, error) {
+	if level == len(binaryLevels) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if !contains(binaryLevels[level], op) {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryOp(op, left, right)
+	}
+}
+
+func (p *exprParser) unary() (pluralExpr, error) {
+	switch t := p.peek(); {
+	case t == "!":
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int64) int64 { return boolInt(x(n) == 0) }, nil
+	case t == "n":
+		p.pos++
+		return func(n int64) int64 { return n }, nil
+	case t == "(":
+		p.pos++
+		x, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("expected ), got %q", p.peek())
+		}
+		p.pos++
+		return x, nil
+	default:
+		v, err := strconv.ParseInt(t, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected %q", t)
+		}
+		p.pos++
+		return func(int64) int64 { return v }, nil
+	}
+}
+
+// binaryOp returns the expression applying the operator op, dividing by zero gives zero.
+func binaryOp(op string, a, b pluralExpr) pluralExpr {
+	return func(n int64) int64 {
+		x, y := a(n), b(n)
+		switch op {
+		case "||":
+			return boolInt(x != 0 || y != 0)
+		case "&&":
+			return boolInt(x != 0 && y != 0)
+		case "==":
+			return boolInt(x == y)
+		case "!=":
+			return boolInt(x != y)
+		case "<":
+			return boolInt(x < y)
+		case ">":
+			return boolInt(x > y)
+		case "<=":
+			return boolInt(x <= y)
+		case ">=":
+			return boolInt(x >= y)
+		case "+":
+			return x + y
+		case "-":
+			return x - y
+		case "*":
+			return x * y
+		}
+		if y == 0 {
+			return 0
+		}
+		if op == "/" {
+			return x / y
+		}
+		return x % y
+	}
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func contains(ops []string, op string) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeExpr splits a C expression into numbers, "n" and operators.
+func tokenizeExpr(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		case i+1 < len(s) && contains([]string{"==", "!=", "<=", ">=", "&&", "||"}, s[i:i+2]):
+			tokens = append(tokens, s[i:i+2])
+			i += 2
+		default:
+			tokens = append(tokens, s[i:i+1])
+			i++
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,61 @@
+// Package durafmtgin logs the latency of Gin requests as a human readable duration.
+package durafmtgin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ihippik/durafmt"
+)
+
+// Config is the output format of the Logger middleware.
+type Config struct {
+	// Output receives a line per request, nil means os.Stdout.
+	Output io.Writer
+	// Style is the name of a registered durafmt style, "" means the default format.
+	Style string
+	// Options set the output format of the latency.
+	Options []durafmt.Option
+	// SlowThreshold highlights requests taking longer, 0 means no highlighting.
+	SlowThreshold time.Duration
+	// Highlight marks the line of a slow request, nil means bold red ANSI colors.
+	Highlight func(line string) string
+}
+
+// LatencyKey is the key of the human readable latency in the gin.Context,
+// for handlers and middlewares running after the request is handled.
+const LatencyKey = "durafmt.latency"
+
+// now returns the current time, replaced in tests.
+var now = time.Now
+
+// Logger returns a middleware writing the method, path, status and latency of every request,
+// e.g. "GET /users 200 1,5 сек.".
+func Logger(cfg Config) gin.HandlerFunc {
+	out := cfg.Output
+	if out == nil {
+		out = os.Stdout
+	}
+	highlight := cfg.Highlight
+	if highlight == nil {
+		highlight = func(line string) string { return "\x1b[1;31m" + line + "\x1b[0m" }
+	}
+
+	return func(c *gin.Context) {
+		start := now()
+		c.Next()
+		latency := now().Sub(start)
+
+		human := durafmt.Parse(latency).WithStyle(cfg.Style).Apply(cfg.Options...).String()
+		c.Set(LatencyKey, human)
+
+		line := fmt.Sprintf("%s %s %d %s", c.Request.Method, c.Request.URL.Path, c.Writer.Status(), human)
+		if cfg.SlowThreshold > 0 && latency > cfg.SlowThreshold {
+			line = highlight(line)
+		}
+		fmt.Fprintln(out, line)
+	}
+}
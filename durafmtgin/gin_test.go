@@ -0,0 +1,42 @@
+package durafmtgin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ihippik/durafmt"
+)
+
+func TestLogger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	clock := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+	defer func() { now = time.Now }()
+
+	var buf bytes.Buffer
+	router := gin.New()
+	router.Use(Logger(Config{
+		Output:        &buf,
+		Options:       []durafmt.Option{func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.DropBelow(durafmt.Hours) }},
+		SlowThreshold: time.Millisecond,
+		Highlight:     func(line string) string { return "SLOW " + line },
+	}))
+	router.GET("/fast", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/slow", func(c *gin.Context) {
+		clock = clock.Add(2 * time.Millisecond)
+		c.Status(http.StatusAccepted)
+	})
+
+	for _, path := range []string{"/fast", "/slow"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := "GET /fast 200 0 ч.\nSLOW GET /slow 202 0 ч.\n"
+	if result := buf.String(); result != expected {
+		t.Errorf("Logger() got %q, expected %q", result, expected)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/ihippik/durafmt/durafmtlogrus
+
+go 1.23.0
+
+require (
+	github.com/ihippik/durafmt v0.0.0
+	github.com/sirupsen/logrus v1.10.2
+)
+
+require golang.org/x/sys v0.13.0 // indirect
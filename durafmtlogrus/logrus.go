@@ -0,0 +1,58 @@
+// Package durafmtlogrus logs human readable durations with logrus.
+package durafmtlogrus
+
+import (
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/sirupsen/logrus"
+)
+
+// Hook is a logrus.Hook replacing every time.Duration field with its human readable string,
+// the raw value is kept under the key with RawSuffix:
+//
+//	logger.AddHook(&durafmtlogrus.Hook{})
+//	logger.WithField("elapsed", 90*time.Minute).Info("done")
+//	// elapsed="1 ч. 30 мин." elapsed_raw=1h30m0s msg=done
+type Hook struct {
+	// RawSuffix is appended to the key of the raw duration, "" means "_raw".
+	RawSuffix string
+	// Options set the output format of the durations.
+	Options []durafmt.Option
+}
+
+// Levels returns all the levels, it implements logrus.Hook.
+func (h *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire rewrites the duration fields of the entry, it implements logrus.Hook.
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	suffix := h.RawSuffix
+	if suffix == "" {
+		suffix = "_raw"
+	}
+
+	// collect first, the fields must not change while they are ranged over.
+	durations := make(map[string]time.Duration)
+	for key, value := range entry.Data {
+		if d, ok := value.(time.Duration); ok {
+			durations[key] = d
+		}
+	}
+	if len(durations) == 0 {
+		return nil
+	}
+
+	// entry.Data may be shared with the logger and other entries, write to a copy.
+	data := make(logrus.Fields, len(entry.Data)+len(durations))
+	for key, value := range entry.Data {
+		data[key] = value
+	}
+	for key, d := range durations {
+		data[key] = durafmt.Parse(d).Apply(h.Options...).String()
+		data[key+suffix] = d
+	}
+	entry.Data = data
+	return nil
+}
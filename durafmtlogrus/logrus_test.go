@@ -0,0 +1,31 @@
+package durafmtlogrus
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHook(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	logger.AddHook(&Hook{
+		Options: []durafmt.Option{durafmt.InLocale(durafmt.English)},
+	})
+
+	fields := logrus.Fields{"elapsed": 90 * time.Minute, "user": "bob"}
+	logger.WithFields(fields).Info("done")
+
+	expected := `level=info msg=done elapsed="1 hour 30 minutes" elapsed_raw=1h30m0s user=bob` + "\n"
+	if result := buf.String(); result != expected {
+		t.Errorf("Hook got %s, expected %s", result, expected)
+	}
+	if _, ok := fields["elapsed"].(time.Duration); !ok {
+		t.Errorf("Hook changed the fields of the caller: %v", fields)
+	}
+}
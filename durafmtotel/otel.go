@@ -0,0 +1,36 @@
+// Package durafmtotel adds human readable durations to OpenTelemetry spans,
+// so traces stay readable in tools that show the raw timestamps only.
+package durafmtotel
+
+import (
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Key is the attribute key of the human readable duration.
+const Key = attribute.Key("duration.human")
+
+// Attribute returns the human readable duration between start and end, formatted with the options.
+func Attribute(start, end time.Time, opts ...durafmt.Option) attribute.KeyValue {
+	return Key.String(durafmt.Parse(end.Sub(start)).Apply(opts...).String())
+}
+
+// FromSpan returns the human readable duration of an ended span, e.g. for exporters
+// and span processors. The attributes of an ended span can not be changed anymore.
+func FromSpan(s sdktrace.ReadOnlySpan, opts ...durafmt.Option) attribute.KeyValue {
+	return Attribute(s.StartTime(), s.EndTime(), opts...)
+}
+
+// End sets the human readable duration since start on the span and ends it:
+//
+//	ctx, span := tracer.Start(ctx, "work")
+//	defer durafmtotel.End(span, time.Now())
+func End(span trace.Span, start time.Time, opts ...durafmt.Option) {
+	end := time.Now()
+	span.SetAttributes(Attribute(start, end, opts...))
+	span.End(trace.WithTimestamp(end))
+}
@@ -0,0 +1,41 @@
+package durafmtotel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAttribute(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	english := durafmt.InLocale(durafmt.English)
+
+	kv := Attribute(start, start.Add(90*time.Minute), english)
+	if kv.Key != "duration.human" || kv.Value.AsString() != "1 hour 30 minutes" {
+		t.Errorf("Attribute() got %v=%q, expected %q", kv.Key, kv.Value.AsString(), "1 hour 30 minutes")
+	}
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "work")
+	End(span, time.Now().Add(-2*time.Hour), func(d *durafmt.Durafmt) *durafmt.Durafmt { return d.LimitFirstN(1) })
+
+	ended := recorder.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("End() ended %d spans, expected 1", len(ended))
+	}
+	attrs := ended[0].Attributes()
+	if len(attrs) != 1 || attrs[0].Value.AsString() != "2 ч." {
+		t.Errorf("End() set %v, expected %q", attrs, "2 ч.")
+	}
+	if kv := FromSpan(ended[0]); kv.Value.AsString() == "" {
+		t.Errorf("FromSpan() got an empty duration")
+	}
+}
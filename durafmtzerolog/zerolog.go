@@ -0,0 +1,21 @@
+// Package durafmtzerolog logs human readable durations with zerolog.
+package durafmtzerolog
+
+import (
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/rs/zerolog"
+)
+
+// HumanSuffix is appended to the key of the raw duration to name the human readable one.
+var HumanSuffix = "_human"
+
+// Dur adds the duration d to e under the key, keeping the raw value as zerolog.Event.Dur does,
+// and its human readable string formatted with the options under the key with HumanSuffix:
+//
+//	durafmtzerolog.Dur(log.Info(), "elapsed", time.Since(start)).Msg("done")
+//	// {"level":"info","elapsed":5400000,"elapsed_human":"1 ч. 30 мин.","message":"done"}
+func Dur(e *zerolog.Event, key string, d time.Duration, opts ...durafmt.Option) *zerolog.Event {
+	return e.Dur(key, d).Str(key+HumanSuffix, durafmt.Parse(d).Apply(opts...).String())
+}
@@ -0,0 +1,23 @@
+package durafmtzerolog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ihippik/durafmt"
+	"github.com/rs/zerolog"
+)
+
+func TestDur(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	english := durafmt.InLocale(durafmt.English)
+
+	Dur(logger.Info(), "elapsed", 90*time.Minute, english).Msg("done")
+
+	expected := `{"level":"info","elapsed":5400000,"elapsed_human":"1 hour 30 minutes","message":"done"}` + "\n"
+	if result := buf.String(); result != expected {
+		t.Errorf("Dur() got %s, expected %s", result, expected)
+	}
+}
@@ -0,0 +1,111 @@
+package durafmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseFFmpeg creates a new *Durafmt struct from an ffmpeg time duration,
+// "[-][HH:]MM:SS[.m...]" such as "00:01:23.456", or "[-]S+[.m...][s|ms|us]" such as "83.456".
+func ParseFFmpeg(input string) (*Durafmt, error) {
+	s := input
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	var duration time.Duration
+	var ok bool
+	if strings.Contains(s, ":") {
+		duration, ok = parseSexagesimal(s, '.')
+	} else {
+		unit := time.Second
+		switch {
+		case strings.HasSuffix(s, "ms"):
+			s, unit = strings.TrimSuffix(s, "ms"), time.Millisecond
+		case strings.HasSuffix(s, "us"):
+			s, unit = strings.TrimSuffix(s, "us"), time.Microsecond
+		case strings.HasSuffix(s, "s"):
+			s = strings.TrimSuffix(s, "s")
+		}
+		duration, ok = parseDecimal(s, '.', unit)
+	}
+	if !ok {
+		return nil, errors.New("durafmt: invalid ffmpeg duration " + strconv.Quote(input))
+	}
+	if negative {
+		duration = -duration
+	}
+	return Parse(duration), nil
+}
+
+// FFmpeg formats the duration as an ffmpeg time duration with milliseconds, e.g. "00:01:23.456".
+func (d *Durafmt) FFmpeg() string {
+	return sexagesimal(d.Duration(), '.')
+}
+
+// sexagesimal formats duration as "HH:MM:SS" and milliseconds after the separator.
+func sexagesimal(duration time.Duration, separator byte) string {
+	positive := abs(duration)
+	s := twoDigits(int64(positive/time.Hour)) + ":" +
+		twoDigits(int64(positive%time.Hour/time.Minute)) + ":" +
+		twoDigits(int64(positive%time.Minute/time.Second)) + string(separator) +
+		strconv.FormatInt(int64(positive%time.Second/time.Millisecond)+1000, 10)[1:]
+	if duration < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// parseSexagesimal parses "[HH:]MM:SS[.m...]" with the decimal separator.
+func parseSexagesimal(s string, separator byte) (time.Duration, bool) {
+	fields := strings.Split(s, ":")
+	if len(fields) > 3 {
+		return 0, false
+	}
+	duration, ok := parseDecimal(fields[len(fields)-1], separator, time.Second)
+	if !ok {
+		return 0, false
+	}
+	unit := time.Minute
+	for i := len(fields) - 2; i >= 0; i-- {
+		n, err := strconv.ParseUint(fields[i], 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		duration = add(duration, mul(unit, int64(n)))
+		unit = time.Hour
+	}
+	return duration, true
+}
+
+// parseDecimal parses an unsigned decimal number of units, "83.456", exactly to the nanosecond.
+func parseDecimal(s string, separator byte, unit time.Duration) (time.Duration, bool) {
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, separator); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" && fraction == "" {
+		return 0, false
+	}
+
+	var duration time.Duration
+	if whole != "" {
+		n, err := strconv.ParseUint(whole, 10, 63)
+		if err != nil {
+			return 0, false
+		}
+		duration = mul(unit, int64(n))
+	}
+	scale := unit
+	for _, c := range fraction {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		scale /= 10
+		duration = add(duration, scale*time.Duration(c-'0'))
+	}
+	return duration, true
+}
@@ -0,0 +1,55 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFFmpeg(t *testing.T) {
+	testParseFFmpeg := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{"00:01:23.456", 83456 * time.Millisecond, false},
+		{"01:23.5", 83500 * time.Millisecond, false},
+		{"-01:00:00", -time.Hour, false},
+		{"83.456", 83456 * time.Millisecond, false},
+		{"200ms", 200 * time.Millisecond, false},
+		{"1500us", 1500 * time.Microsecond, false},
+		{"12s", 12 * time.Second, false},
+		{".5", 500 * time.Millisecond, false},
+		{"0.123456789", 123456789 * time.Nanosecond, false},
+		{"1:2:3:4", 0, true},
+		{"1h", 0, true},
+		{"", 0, true},
+	}
+
+	for _, table := range testParseFFmpeg {
+		d, err := ParseFFmpeg(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParseFFmpeg(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParseFFmpeg(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
+
+func TestFFmpeg(t *testing.T) {
+	testFFmpeg := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{83456 * time.Millisecond, "00:01:23.456"},
+		{26*time.Hour + 5*time.Millisecond, "26:00:00.005"},
+		{-time.Second, "-00:00:01.000"},
+	}
+
+	for _, table := range testFFmpeg {
+		if result := Parse(table.test).FFmpeg(); result != table.expected {
+			t.Errorf("Parse(%v).FFmpeg() got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package durafmt
+
+import (
+	"strings"
+	"time"
+)
+
+// DurationSlice is a flag.Value, and a pflag.Value, collecting the durations of a repeated flag:
+//
+//	var retries durafmt.DurationSlice
+//	flag.Var(&retries, "retry-after", "retry delay, repeatable")
+//
+// Every value is parsed with ParseLenient, or with ParsePromQL for the units d, w and y,
+// so "--retry-after 1s --retry-after 1m30s --retry-after 1d" all work.
+type DurationSlice []time.Duration
+
+// Set parses the duration value and appends it, implementing flag.Value.
+func (s *DurationSlice) Set(value string) error {
+	d, err := ParseLenient(value)
+	if err != nil {
+		var promErr error
+		if d, promErr = ParsePromQL(value); promErr != nil {
+			return err
+		}
+	}
+	*s = append(*s, d.Duration())
+	return nil
+}
+
+// String returns the human readable durations separated by commas, e.g. "1 сек., 5 сек.",
+// so help messages show defaults readably.
+func (s *DurationSlice) String() string {
+	if s == nil {
+		return ""
+	}
+	return strings.Join(FormatAll(*s), ", ")
+}
+
+// Type returns the name of the value type shown by pflag, implementing pflag.Value.
+func (s *DurationSlice) Type() string {
+	return "durations"
+}
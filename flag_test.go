@@ -0,0 +1,40 @@
+package durafmt
+
+import (
+	"flag"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDurationSlice(t *testing.T) {
+	var retries DurationSlice
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	flags.Var(&retries, "retry-after", "retry delay")
+
+	args := []string{"--retry-after", "1s", "--retry-after=1m30s", "--retry-after", "90", "--retry-after", "1d"}
+	if err := flags.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	expected := DurationSlice{time.Second, 90 * time.Second, 90 * time.Second, 24 * time.Hour}
+	if !reflect.DeepEqual(retries, expected) {
+		t.Errorf("DurationSlice got %v, expected %v", retries, expected)
+	}
+	if result := retries.String(); result != "1 сек., 1 мин. 30 сек., 1 мин. 30 сек., 1 дн." {
+		t.Errorf("DurationSlice.String() got %q", result)
+	}
+
+	if err := flags.Parse([]string{"--retry-after", "soon"}); err == nil {
+		t.Errorf("DurationSlice.Set(%q) expected an error", "soon")
+	}
+
+	var none *DurationSlice
+	if result := none.String(); result != "" {
+		t.Errorf("nil DurationSlice.String() got %q, expected %q", result, "")
+	}
+	if result := none.Type(); result != "durations" {
+		t.Errorf("DurationSlice.Type() got %q, expected %q", result, "durations")
+	}
+}
@@ -0,0 +1,38 @@
+package durafmt
+
+import (
+	"math"
+	"time"
+)
+
+// FromNanoseconds creates a new *Durafmt struct from a number of nanoseconds,
+// e.g. a JSON number or a template argument.
+func FromNanoseconds(ns int64) *Durafmt {
+	return Parse(time.Duration(ns))
+}
+
+// FromMilliseconds creates a new *Durafmt struct from a number of milliseconds,
+// saturating at the extremes of time.Duration.
+func FromMilliseconds(ms int64) *Durafmt {
+	return Parse(mul(time.Millisecond, ms))
+}
+
+// FromSecondsFloat creates a new *Durafmt struct from a number of seconds, like FromSeconds.
+func FromSecondsFloat(s float64) *Durafmt {
+	return FromSeconds(s)
+}
+
+// FromSeconds creates a new *Durafmt struct from a number of seconds, keeping the fraction
+// down to the nanosecond, e.g. 1.5 is "1 сек. 500 млс.". The whole seconds and the fraction
+// are converted apart, so large values do not lose the nanoseconds to float64 rounding.
+// The result saturates at the extremes of time.Duration. NaN is a zero duration.
+func FromSeconds(f float64) *Durafmt {
+	if math.IsNaN(f) {
+		return Parse(0)
+	}
+	seconds, fraction := math.Modf(f)
+	if whole := saturate(seconds * float64(time.Second)); whole == math.MaxInt64 || whole == math.MinInt64 {
+		return Parse(whole)
+	}
+	return Parse(add(mul(time.Second, int64(seconds)), saturate(fraction*float64(time.Second))))
+}
@@ -0,0 +1,39 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFromNumbers(t *testing.T) {
+	testFromNumbers := []struct {
+		test     *Durafmt
+		expected time.Duration
+	}{
+		{FromNanoseconds(1500), 1500 * time.Nanosecond},
+		{FromMilliseconds(90000), 90 * time.Second},
+		{FromMilliseconds(math.MaxInt64), math.MaxInt64},
+		{FromMilliseconds(math.MinInt64), math.MinInt64},
+		{FromSecondsFloat(1.5), 1500 * time.Millisecond},
+		{FromSecondsFloat(-0.25), -250 * time.Millisecond},
+		{FromSecondsFloat(1e300), math.MaxInt64},
+		{FromSecondsFloat(math.Inf(-1)), math.MinInt64},
+		{FromSecondsFloat(math.NaN()), 0},
+		{FromSeconds(0.000000001), time.Nanosecond},
+		{FromSeconds(-1.5), -1500 * time.Millisecond},
+		{FromSeconds(3.000000001), 3*time.Second + time.Nanosecond},
+		{FromSeconds(1600000000.0000005), 1600000000*time.Second + 477*time.Nanosecond},
+		{FromSeconds(9.3e9), math.MaxInt64},
+	}
+
+	for _, table := range testFromNumbers {
+		if result := table.test.Duration(); result != table.expected {
+			t.Errorf("Duration() got %v, expected %v", result, table.expected)
+		}
+	}
+
+	if result, expected := FromMilliseconds(5400000).String(), "1 ч. 30 мин."; result != expected {
+		t.Errorf("FromMilliseconds(5400000).String() got %q, expected %q", result, expected)
+	}
+}
@@ -0,0 +1,106 @@
+package durafmt
+
+import (
+	"math"
+	"strconv"
+)
+
+// FuzzyLevel is how far from a round amount of a unit a duration may be
+// to be described colloquially, e.g. "почти 2 часа".
+type FuzzyLevel int
+
+const (
+	// FuzzyOff always describes durations exactly.
+	FuzzyOff FuzzyLevel = iota
+	// FuzzyLow describes durations within a tenth of a unit from a round amount.
+	FuzzyLow
+	// FuzzyMedium describes durations within a quarter of a unit from a round amount.
+	FuzzyMedium
+	// FuzzyHigh describes every duration colloquially.
+	FuzzyHigh
+)
+
+var fuzzyTolerances = []float64{0, 0.1, 0.25, 0.5}
+
+// Qualifier tells how a duration relates to the round amount it is described with.
+type Qualifier int
+
+const (
+	// About is close to the round amount, "около трех дней".
+	About Qualifier = iota
+	// Almost is a bit less than the round amount, "почти 2 часа".
+	Almost
+	// JustOver is a bit more than the round amount, "чуть больше часа".
+	JustOver
+)
+
+// Fuzzy sets the output format, describing durations close to a round amount of a unit
+// with a colloquial qualifier, e.g. "почти 2 часа", "чуть больше часа" or "около 3 дней".
+// Durations further than the level allows are formatted exactly.
+func (d *Durafmt) Fuzzy(level FuzzyLevel) *Durafmt {
+	c := d.Clone()
+	c.fuzzy = level
+	return c
+}
+
+// fuzzed returns the colloquial phrase of the duration.
+func (d *Durafmt) fuzzed() (string, bool) {
+	locale := d.Locale()
+	if d.fuzzy <= FuzzyOff || int(d.fuzzy) >= len(fuzzyTolerances) || locale.Fuzzy == nil {
+		return "", false
+	}
+	tolerance := fuzzyTolerances[d.fuzzy]
+
+	duration := abs(d.duration)
+	for u := Years; u <= Microseconds; u++ {
+		v := float64(duration) / float64(u.Duration())
+		n := math.Round(v)
+		diff := v - n
+		if n < 1 || math.Abs(diff) > tolerance {
+			continue
+		}
+		if diff == 0 {
+			return "", false
+		}
+
+		q := About
+		switch {
+		case diff < -tolerance/2:
+			q = Almost
+		case diff > tolerance/2:
+			q = JustOver
+		}
+		return TransliterateDigits(locale.Fuzzy(locale, q, int64(n), u), locale.Digits), true
+	}
+	return "", false
+}
+
+// russianFuzzy describes n units of u in Russian, "почти час" or "около 3 дней".
+func russianFuzzy(l Locale, q Qualifier, n int64, u Unit) string {
+	gc, qualifier := Genitive, "около "
+	switch q {
+	case Almost:
+		gc, qualifier = Nominative, "почти "
+	case JustOver:
+		qualifier = "чуть больше "
+	}
+	if n == 1 {
+		return qualifier + l.word(u, PluralOne, gc)
+	}
+	return qualifier + strconv.FormatInt(n, 10) + " " + l.word(u, l.plural(n), gc)
+}
+
+// englishFuzzy describes n units of u in English, "almost an hour" or "about 3 days".
+func englishFuzzy(l Locale, q Qualifier, n int64, u Unit) string {
+	qualifier := "about "
+	switch q {
+	case Almost:
+		qualifier = "almost "
+	case JustOver:
+		qualifier = "just over "
+	}
+	if n == 1 {
+		return qualifier + l.SingleUnits[u]
+	}
+	return qualifier + strconv.FormatInt(n, 10) + " " + l.word(u, l.plural(n), Nominative)
+}
@@ -0,0 +1,36 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFuzzy(t *testing.T) {
+	testTimesFuzzy := []struct {
+		test     time.Duration
+		level    FuzzyLevel
+		locale   Locale
+		expected string
+	}{
+		{time.Hour + 55*time.Minute, FuzzyOff, Russian, "1 ч. 55 мин."},
+		{time.Hour + 55*time.Minute, FuzzyLow, Russian, "почти 2 часа"},
+		{time.Hour + 10*time.Minute, FuzzyMedium, Russian, "чуть больше часа"},
+		{72*time.Hour + time.Hour, FuzzyLow, Russian, "около 3 дней"},
+		{-(21*24*time.Hour + 2*time.Hour), FuzzyLow, Russian, "около 3 недель"},
+		{55 * time.Minute, FuzzyLow, Russian, "почти час"},
+		{5*time.Hour + 40*time.Minute, FuzzyHigh, Russian, "почти 6 часов"},
+		{time.Hour + 30*time.Minute, FuzzyLow, Russian, "1 ч. 30 мин."},
+		{2 * time.Hour, FuzzyHigh, Russian, "2 ч."},
+		{55 * time.Minute, FuzzyLow, English, "almost an hour"},
+		{time.Hour + 10*time.Minute, FuzzyMedium, English, "just over an hour"},
+		{72*time.Hour + time.Hour, FuzzyLow, English, "about 3 days"},
+	}
+
+	for _, table := range testTimesFuzzy {
+		result := Parse(table.test).WithLocale(table.locale).Fuzzy(table.level).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Fuzzy(%d).String() got %q, expected %q",
+				table.test, table.level, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package durafmt
+
+import "strings"
+
+// icuEscaper quotes the ICU MessageFormat syntax characters of literal text.
+var icuEscaper = strings.NewReplacer("'", "''", "{", "'{'", "}", "'}'", "#", "'#'")
+
+// ICUArgs returns the displayed units as ICU MessageFormat arguments keyed by unit name,
+// e.g. {"hours": 2, "minutes": 30}, to render ICUMessage in i18next or ICU pipelines.
+// The values are positive, the sign is part of the message.
+func (d *Durafmt) ICUArgs() map[string]int64 {
+	args := make(map[string]int64)
+	for _, c := range d.components() {
+		args[c.unit.String()] = c.value
+	}
+	return args
+}
+
+// ICUMessage returns an ICU MessageFormat pattern of the displayed units with the plural forms
+// of the locale, e.g. "{hours, plural, one {# hour} other {# hours}}" with full words,
+// so frontends render the duration from ICUArgs with their own number formatting.
+func (d *Durafmt) ICUMessage() string {
+	if d == nil {
+		return ""
+	}
+	return d.join(d.components(), d.icuUnit)
+}
+
+// icuUnit formats a single component as an ICU plural argument.
+func (d *Durafmt) icuUnit(c component) string {
+	locale := d.Locale()
+	sep := icuEscaper.Replace(d.unitSeparator())
+	var b strings.Builder
+	b.WriteString("{" + c.unit.String() + ", plural,")
+	add := func(selector, form string) {
+		b.WriteString(" " + selector + " {#" + sep + icuEscaper.Replace(form) + "}")
+	}
+
+	abbr, custom := d.abbreviations[c.unit]
+	switch {
+	case d.words:
+		for _, category := range pluralCategories(locale) {
+			add(category.String(), locale.word(c.unit, category, d.grammaticalCase))
+		}
+	case custom:
+		add("other", abbr)
+	default:
+		if _, ok := locale.unitPlurals(c.unit); ok {
+			for _, category := range pluralCategories(locale) {
+				add(category.String(), locale.unit(c.unit, category))
+			}
+			break
+		}
+		add("other", locale.Units[c.unit])
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestICU(t *testing.T) {
+	testICU := []struct {
+		d       *Durafmt
+		message string
+		args    map[string]int64
+	}{
+		{
+			Parse(2*time.Hour + 30*time.Minute).WithLocale(English).Accessible(),
+			"{hours, plural, one {# hour} other {# hours}} {minutes, plural, one {# minute} other {# minutes}}",
+			map[string]int64{"hours": 2, "minutes": 30},
+		},
+		{
+			Parse(-21 * time.Hour).Accessible(),
+			"-{hours, plural, one {# час} few {# часа} many {# часов} other {# часа}}",
+			map[string]int64{"hours": 21},
+		},
+		{
+			Parse(time.Hour + 5*time.Second).WithLocale(English),
+			"{hours, plural, one {# hour} other {# hours}} {seconds, plural, one {# second} other {# seconds}}",
+			map[string]int64{"hours": 1, "seconds": 5},
+		},
+		{
+			Parse(90 * time.Second).WithAbbreviations(map[Unit]string{Minutes: "min{s}"}),
+			"{minutes, plural, other {# min'{'s'}'}} {seconds, plural, other {# сек.}}",
+			map[string]int64{"minutes": 1, "seconds": 30},
+		},
+	}
+
+	for _, table := range testICU {
+		if result := table.d.ICUMessage(); result != table.message {
+			t.Errorf("ICUMessage() got %q, expected %q", result, table.message)
+		}
+		if result := table.d.ICUArgs(); !reflect.DeepEqual(result, table.args) {
+			t.Errorf("ICUArgs() got %v, expected %v", result, table.args)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package plural parses the CLDR plural rules of integers, e.g. "n % 10 = 1 and n % 100 != 11",
+// so locale bundles can be evaluated at run time or compiled into Go source.
+package plural
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Rule is a parsed plural rule, the disjunction of conjunctions of relations.
+type Rule struct {
+	or [][]relation
+}
+
+// relation is the comparison of an operand, optionally modulo mod, with a list of ranges.
+type relation struct {
+	zero   bool // The operand is always zero for integers: v, w, f, t, c, e.
+	mod    int64
+	not    bool
+	ranges [][2]int64
+}
+
+// Parse parses a CLDR plural rule. The samples following "@" are ignored.
+// Only the integer operands are supported: n and i are the number, v, w, f, t, c and e are zero.
+func Parse(s string) (Rule, error) {
+	if i := strings.IndexByte(s, '@'); i >= 0 {
+		s = s[:i]
+	}
+	p := parser{tokens: tokenize(s)}
+	if len(p.tokens) == 0 {
+		return Rule{}, errors.New("plural: empty rule")
+	}
+
+	var r Rule
+	for {
+		var and []relation
+		for {
+			rel, err := p.relation()
+			if err != nil {
+				return Rule{}, fmt.Errorf("plural: %q: %v", s, err)
+			}
+			and = append(and, rel)
+			if !p.accept("and") {
+				break
+			}
+		}
+		r.or = append(r.or, and)
+		if !p.accept("or") {
+			break
+		}
+	}
+	if p.pos < len(p.tokens) {
+		return Rule{}, fmt.Errorf("plural: %q: unexpected %q", s, p.tokens[p.pos])
+	}
+	return r, nil
+}
+
+// Match reports whether the rule holds for n.
+func (r Rule) Match(n int64) bool {
+	if n < 0 {
+		n = -n
+	}
+	for _, and := range r.or {
+		ok := true
+		for _, rel := range and {
+			if !rel.match(n) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GoExpr returns the rule as a Go boolean expression of the non-negative int64 variable v.
+// The relations of the operands always zero for integers are folded.
+func (r Rule) GoExpr(v string) string {
+	var or []string
+	for _, and := range r.or {
+		var exprs []string
+		for _, rel := range and {
+			switch {
+			case !rel.zero:
+				exprs = append(exprs, rel.goExpr(v))
+			case !rel.match(0):
+				exprs = []string{"false"}
+			}
+			if len(exprs) == 1 && exprs[0] == "false" {
+				break
+			}
+		}
+		switch {
+		case len(exprs) == 0:
+			return "true"
+		case exprs[0] == "false":
+		case len(exprs) == 1:
+			or = append(or, exprs[0])
+		default:
+			or = append(or, "("+strings.Join(exprs, " && ")+")")
+		}
+	}
+	switch len(or) {
+	case 0:
+		return "false"
+	case 1:
+		return strings.TrimSuffix(strings.TrimPrefix(or[0], "("), ")")
+	}
+	return strings.Join(or, " || ")
+}
+
+func (rel relation) operand(n int64) int64 {
+	if rel.zero {
+		return 0
+	}
+	if rel.mod > 0 {
+		return n % rel.mod
+	}
+	return n
+}
+
+func (rel relation) match(n int64) bool {
+	x := rel.operand(n)
+	in := false
+	for _, r := range rel.ranges {
+		if x >= r[0] && x <= r[1] {
+			in = true
+			break
+		}
+	}
+	return in != rel.not
+}
+
+func (rel relation) goExpr(v string) string {
+	x := v
+	if rel.mod > 0 {
+		x += "%" + strconv.FormatInt(rel.mod, 10)
+	}
+	if len(rel.ranges) == 1 && rel.ranges[0][0] == rel.ranges[0][1] {
+		op := " == "
+		if rel.not {
+			op = " != "
+		}
+		return x + op + strconv.FormatInt(rel.ranges[0][0], 10)
+	}
+
+	in := make([]string, len(rel.ranges))
+	for i, r := range rel.ranges {
+		if r[0] == r[1] {
+			in[i] = x + " == " + strconv.FormatInt(r[0], 10)
+		} else {
+			in[i] = x + " >= " + strconv.FormatInt(r[0], 10) + " && " + x + " <= " + strconv.FormatInt(r[1], 10)
+		}
+	}
+	expr := "(" + strings.Join(in, " || ") + ")"
+	if rel.not {
+		return "!" + expr
+	}
+	return expr
+}
+
+// parser reads a rule token by token.
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *parser) accept(t string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos] == t {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// relation parses "operand [% value] (=|!=) ranges".
+func (p *parser) relation() (relation, error) {
+	var rel relation
+	switch operand := p.next(); operand {
+	case "n", "i":
+	case "v", "w", "f", "t", "c", "e":
+		rel.zero = true
+	default:
+		return rel, fmt.Errorf("unknown operand %q", operand)
+	}
+	if p.accept("%") {
+		mod, err := p.value()
+		if err != nil {
+			return rel, err
+		}
+		if mod <= 0 {
+			return rel, errors.New("modulo by zero")
+		}
+		rel.mod = mod
+	}
+
+	switch op := p.next(); op {
+	case "=":
+	case "!=":
+		rel.not = true
+	default:
+		return rel, fmt.Errorf("expected = or !=, got %q", op)
+	}
+
+	for {
+		from, err := p.value()
+		if err != nil {
+			return rel, err
+		}
+		to := from
+		if p.accept("..") {
+			if to, err = p.value(); err != nil {
+				return rel, err
+			}
+		}
+		rel.ranges = append(rel.ranges, [2]int64{from, to})
+		if !p.accept(",") {
+			return rel, nil
+		}
+	}
+}
+
+func (p *parser) value() (int64, error) {
+	t := p.next()
+	v, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a number, got %q", t)
+	}
+	return v, nil
+}
+
+// tokenize splits a rule into words, numbers and the operators %, =, !=, .. and ",".
+func tokenize(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], ".."):
+			tokens = append(tokens, s[i:i+2])
+			i += 2
+		case c >= '0' && c <= '9' || c >= 'a' && c <= 'z':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] >= 'a' && s[j] <= 'z') {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		default:
+			tokens = append(tokens, s[i:i+1])
+			i++
+		}
+	}
+	return tokens
+}
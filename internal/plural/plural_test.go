@@ -0,0 +1,50 @@
+package plural
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	testRules := []struct {
+		rule    string
+		goExpr  string
+		matches []int64
+		misses  []int64
+	}{
+		{"n = 1", "n == 1", []int64{1, -1}, []int64{0, 2, 11}},
+		{"i = 1 and v = 0 @integer 1", "n == 1", []int64{1}, []int64{0, 21}},
+		{"n % 10 = 1 and n % 100 != 11", "n%10 == 1 && n%100 != 11", []int64{1, 21, 101}, []int64{11, 111, 2}},
+		{"n % 10 = 2..4 and n % 100 != 12..14", "(n%10 >= 2 && n%10 <= 4) && !(n%100 >= 12 && n%100 <= 14)", []int64{2, 23, 104}, []int64{12, 14, 5}},
+		{"n = 0 or n % 100 = 3..10,13", "n == 0 || (n%100 >= 3 && n%100 <= 10 || n%100 == 13)", []int64{0, 3, 10, 113}, []int64{1, 11, 12}},
+		{"v != 0 and n = 1 or n = 2", "n == 2", []int64{2}, []int64{1}},
+		{"v = 0", "true", []int64{0, 7}, nil},
+		{"v = 0 and i % 10 = 1 or n = 5", "n%10 == 1 || n == 5", []int64{1, 5, 31}, []int64{6}},
+	}
+
+	for _, table := range testRules {
+		r, err := Parse(table.rule)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", table.rule, err)
+			continue
+		}
+		if result := r.GoExpr("n"); result != table.goExpr {
+			t.Errorf("Parse(%q).GoExpr() got %q, expected %q", table.rule, result, table.goExpr)
+		}
+		for _, n := range table.matches {
+			if !r.Match(n) {
+				t.Errorf("Parse(%q).Match(%d) got false, expected true", table.rule, n)
+			}
+		}
+		for _, n := range table.misses {
+			if r.Match(n) {
+				t.Errorf("Parse(%q).Match(%d) got true, expected false", table.rule, n)
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, rule := range []string{"", "x = 1", "n = ", "n % 0 = 1", "n < 1", "n = 1 and", "n = 1 2"} {
+		if _, err := Parse(rule); err == nil {
+			t.Errorf("Parse(%q) expected an error", rule)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package durafmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jsonSuffixes are the name suffixes of JSON fields holding a number of a time unit.
+var jsonSuffixes = []struct {
+	suffix string
+	unit   time.Duration
+}{
+	{"_duration_ns", time.Nanosecond},
+	{"_duration_us", time.Microsecond},
+	{"_duration_ms", time.Millisecond},
+	{"_duration_s", time.Second},
+}
+
+// HumanizeJSON returns the JSON document data with its durations replaced by their
+// human readable form, e.g. for rendering API payloads in admin UIs. The options set the output format.
+//
+// The values at paths are durations: numbers are nanoseconds, as time.Duration encodes,
+// and strings are Go durations such as "1m30s". A path is a dot separated list of object keys
+// and array indexes, "*" matching any of them, e.g. "jobs.*.timeout". Number fields named
+// after the "_duration_ns", "_duration_us", "_duration_ms" and "_duration_s" conventions are durations
+// of the unit wherever they are. Object keys are sorted in the output.
+func HumanizeJSON(data []byte, paths []string, opts ...Option) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	patterns := make([][]string, len(paths))
+	for i, path := range paths {
+		patterns[i] = strings.Split(path, ".")
+	}
+	return json.Marshal(humanizeJSON(doc, nil, patterns, opts))
+}
+
+// humanizeJSON replaces the durations in v found at path of the document.
+func humanizeJSON(v interface{}, path []string, patterns [][]string, opts []Option) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = humanizeJSON(value, append(path[:len(path):len(path)], key), patterns, opts)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = humanizeJSON(value, append(path[:len(path):len(path)], strconv.Itoa(i)), patterns, opts)
+		}
+		return v
+	}
+
+	if duration, ok := jsonDuration(v, path, patterns); ok {
+		return Parse(duration).Apply(opts...).String()
+	}
+	return v
+}
+
+// jsonDuration returns the duration held by the value v at path.
+func jsonDuration(v interface{}, path []string, patterns [][]string) (time.Duration, bool) {
+	for _, pattern := range patterns {
+		if !matchPath(path, pattern) {
+			continue
+		}
+		switch v := v.(type) {
+		case json.Number:
+			return jsonNumber(v, time.Nanosecond)
+		case string:
+			duration, err := time.ParseDuration(v)
+			return duration, err == nil
+		}
+		return 0, false
+	}
+
+	number, ok := v.(json.Number)
+	if !ok || len(path) == 0 {
+		return 0, false
+	}
+	for _, s := range jsonSuffixes {
+		if strings.HasSuffix(path[len(path)-1], s.suffix) {
+			return jsonNumber(number, s.unit)
+		}
+	}
+	return 0, false
+}
+
+// jsonNumber returns the duration of n units.
+func jsonNumber(n json.Number, unit time.Duration) (time.Duration, bool) {
+	if i, err := n.Int64(); err == nil {
+		return mul(time.Duration(i), int64(unit)), true
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return FromSeconds(f * unit.Seconds()).Duration(), true
+}
+
+// matchPath reports whether path matches the pattern, "*" matching any key or index.
+func matchPath(path, pattern []string) bool {
+	if len(path) != len(pattern) {
+		return false
+	}
+	for i := range path {
+		if pattern[i] != "*" && pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package durafmt
+
+import "testing"
+
+func TestHumanizeJSON(t *testing.T) {
+	testHumanizeJSON := []struct {
+		input    string
+		paths    []string
+		expected string
+	}{
+		{`{"name":"build","elapsed_duration_ms":90500}`, nil, `{"elapsed_duration_ms":"1 мин. 30 сек. 500 млс.","name":"build"}`},
+		{`{"wait_duration_s":1.5,"retries":3}`, nil, `{"retries":3,"wait_duration_s":"1 сек. 500 млс."}`},
+		{`{"jobs":[{"timeout":"1h30m"},{"timeout":60000000000}]}`, []string{"jobs.*.timeout"}, `{"jobs":[{"timeout":"1 ч. 30 мин."},{"timeout":"1 мин."}]}`},
+		{`{"timeout":"soon","other":"1h"}`, []string{"timeout"}, `{"other":"1h","timeout":"soon"}`},
+		{`[7200000000000]`, []string{"0"}, `["2 ч."]`},
+	}
+
+	for _, table := range testHumanizeJSON {
+		result, err := HumanizeJSON([]byte(table.input), table.paths)
+		if err != nil {
+			t.Errorf("HumanizeJSON(%q) failed: %v", table.input, err)
+			continue
+		}
+		if string(result) != table.expected {
+			t.Errorf("HumanizeJSON(%q) got %q, expected %q", table.input, result, table.expected)
+		}
+	}
+
+	if _, err := HumanizeJSON([]byte(`{"a":`), nil); err == nil {
+		t.Errorf("HumanizeJSON() expected an error for invalid JSON")
+	}
+}
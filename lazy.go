@@ -0,0 +1,25 @@
+package durafmt
+
+import (
+	"fmt"
+	"time"
+)
+
+// lazy formats its duration only when String is called.
+type lazy struct {
+	d    time.Duration
+	opts []Option
+}
+
+// Lazy returns a fmt.Stringer formatting d with the options, the same as Parse(d).Apply(opts...),
+// but only when String is called. Log arguments filtered out by the level cost no formatting:
+//
+//	log.Debug("request done", "took", durafmt.Lazy(elapsed))
+func Lazy(d time.Duration, opts ...Option) fmt.Stringer {
+	return lazy{d: d, opts: opts}
+}
+
+// String formats the duration, every call formats it again.
+func (l lazy) String() string {
+	return Parse(l.d).Apply(l.opts...).String()
+}
@@ -0,0 +1,30 @@
+package durafmt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	counted := func(d *Durafmt) *Durafmt {
+		calls++
+		return d.WithLocale(English).LimitFirstN(1)
+	}
+
+	s := Lazy(2*time.Hour+30*time.Minute, counted)
+	if calls != 0 {
+		t.Errorf("Lazy() applied the options %d times, expected none before String", calls)
+	}
+	if result := fmt.Sprint(s); result != "2 hours" {
+		t.Errorf("Lazy() got %q, expected %q", result, "2 hours")
+	}
+	if calls != 1 {
+		t.Errorf("Lazy() applied the options %d times, expected once", calls)
+	}
+
+	if result := Lazy(90 * time.Second).String(); result != "1 мин. 30 сек." {
+		t.Errorf("Lazy() got %q, expected %q", result, "1 мин. 30 сек.")
+	}
+}
@@ -0,0 +1,87 @@
+package durafmt
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FormatList formats every duration with the options and joins them into a list
+// following the locale, e.g. "2 ч., 15 мин. и 30 сек.".
+func FormatList(ds []time.Duration, opts ...Option) string {
+	items, locale := formatItems(ds, opts)
+	return joinList(items, locale)
+}
+
+// FormatListRespectively is like FormatList but ends the list with the locale word for
+// "respectively", e.g. "2 ч., 15 мин. и 30 сек. соответственно".
+func FormatListRespectively(ds []time.Duration, opts ...Option) string {
+	items, locale := formatItems(ds, opts)
+	if len(items) == 0 || locale.Respectively == "" {
+		return joinList(items, locale)
+	}
+	return joinList(items, locale) + " " + locale.Respectively
+}
+
+// FormatAll formats every duration with the options, the same as Parse(d).Apply(opts...).String(),
+// into a slice sized once for the whole report. The options are applied to every duration,
+// so options depending on it, such as RoundToIncrement or ClampMin, work as they do alone.
+func FormatAll(ds []time.Duration, opts ...Option) []string {
+	out := make([]string, len(ds))
+	formatInto(out, ds, opts)
+	return out
+}
+
+// FormatAllParallel is like FormatAll but shards the slice across workers goroutines,
+// for datasets large enough to keep several cores busy. The output is in the order of ds.
+// workers <= 0 means runtime.GOMAXPROCS(0). The options are called from several goroutines.
+func FormatAllParallel(ds []time.Duration, workers int, opts ...Option) []string {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(ds) {
+		workers = len(ds)
+	}
+	if workers <= 1 {
+		return FormatAll(ds, opts...)
+	}
+
+	out := make([]string, len(ds))
+	shard := (len(ds) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(ds); start += shard {
+		end := start + shard
+		if end > len(ds) {
+			end = len(ds)
+		}
+		wg.Add(1)
+		go func(out []string, ds []time.Duration) {
+			defer wg.Done()
+			formatInto(out, ds, opts)
+		}(out[start:end], ds[start:end])
+	}
+	wg.Wait()
+	return out
+}
+
+// formatInto formats ds into out with the options.
+func formatInto(out []string, ds []time.Duration, opts []Option) {
+	for i, d := range ds {
+		out[i] = Parse(d).Apply(opts...).format()
+	}
+}
+
+// formatItems formats every duration with the options, returning the locale they used.
+func formatItems(ds []time.Duration, opts []Option) ([]string, Locale) {
+	return FormatAll(ds, opts...), Parse(0).Apply(opts...).Locale()
+}
+
+// joinList joins items with the list separators of the locale.
+func joinList(items []string, l Locale) string {
+	if len(items) < 2 || l.ListAnd == "" {
+		return strings.Join(items, l.ListSeparator)
+	}
+	last := len(items) - 1
+	return strings.Join(items[:last], l.ListSeparator) + l.ListAnd + items[last]
+}
@@ -0,0 +1,111 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFormatList(t *testing.T) {
+	ds := []time.Duration{2 * time.Hour, 15 * time.Minute, 30 * time.Second}
+	english := InLocale(English)
+
+	comma := Russian
+	comma.ListAnd = ""
+	commaList := func(d *Durafmt) *Durafmt { return d.WithLocale(comma) }
+
+	testLists := []struct {
+		test     string
+		expected string
+	}{
+		{FormatList(ds), "2 ч., 15 мин. и 30 сек."},
+		{FormatList(ds[:1]), "2 ч."},
+		{FormatList(nil), ""},
+		{FormatList(ds, english), "2 hours, 15 minutes and 30 seconds"},
+		{FormatList(ds, commaList), "2 ч., 15 мин., 30 сек."},
+		{FormatListRespectively(ds), "2 ч., 15 мин. и 30 сек. соответственно"},
+		{FormatListRespectively(ds[:2], english), "2 hours and 15 minutes respectively"},
+	}
+
+	for _, table := range testLists {
+		if table.test != table.expected {
+			t.Errorf("FormatList() got %q, expected %q", table.test, table.expected)
+		}
+	}
+}
+
+func TestFormatAll(t *testing.T) {
+	ds := []time.Duration{2 * time.Hour, -90 * time.Second, 0, 354*time.Hour + 22*time.Minute}
+	limit := func(d *Durafmt) *Durafmt { return d.LimitFirstN(1) }
+
+	got := FormatAll(ds, limit)
+	if len(got) != len(ds) {
+		t.Fatalf("FormatAll() got %d strings, expected %d", len(got), len(ds))
+	}
+	for i, d := range ds {
+		expected := Parse(d).Apply(limit).String()
+		if got[i] != expected {
+			t.Errorf("FormatAll(%s) got %q, expected %q", d, got[i], expected)
+		}
+	}
+
+	roundUp := func(d *Durafmt) *Durafmt { return d.RoundToIncrement(15*time.Minute, RoundUp) }
+	clamp := func(d *Durafmt) *Durafmt { return d.ClampMin(15 * time.Minute) }
+	testOptions := []struct {
+		test     []string
+		expected []string
+	}{
+		{FormatAll([]time.Duration{61 * time.Minute, 2 * time.Hour}, roundUp), []string{"1 ч. 15 мин.", "2 ч."}},
+		{FormatAll([]time.Duration{89 * time.Second, time.Hour}, clamp), []string{"менее 15 мин.", "1 ч."}},
+		{FormatAllParallel([]time.Duration{61 * time.Minute, 2 * time.Hour}, 2, roundUp), []string{"1 ч. 15 мин.", "2 ч."}},
+	}
+	for _, table := range testOptions {
+		if !reflect.DeepEqual(table.test, table.expected) {
+			t.Errorf("FormatAll() got %q, expected %q", table.test, table.expected)
+		}
+	}
+	if result := FormatList([]time.Duration{61 * time.Minute, 2 * time.Hour}, roundUp); result != "1 ч. 15 мин. и 2 ч." {
+		t.Errorf("FormatList() got %q, expected %q", result, "1 ч. 15 мин. и 2 ч.")
+	}
+
+	if got := FormatAll(nil); len(got) != 0 {
+		t.Errorf("FormatAll(nil) got %q, expected none", got)
+	}
+}
+
+func TestFormatAllParallel(t *testing.T) {
+	ds := make([]time.Duration, 1001)
+	for i := range ds {
+		ds[i] = time.Duration(i*i) * 7919 * time.Millisecond
+	}
+	english := InLocale(English)
+	expected := FormatAll(ds, english)
+
+	for _, workers := range []int{0, 1, 3, 8, 2000} {
+		got := FormatAllParallel(ds, workers, english)
+		if len(got) != len(expected) {
+			t.Fatalf("FormatAllParallel(%d) got %d strings, expected %d", workers, len(got), len(expected))
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Errorf("FormatAllParallel(%d)[%d] got %q, expected %q", workers, i, got[i], expected[i])
+			}
+		}
+	}
+
+	if got := FormatAllParallel(nil, 4); len(got) != 0 {
+		t.Errorf("FormatAllParallel(nil) got %q, expected none", got)
+	}
+}
+
+func BenchmarkFormatAll(b *testing.B) {
+	ds := make([]time.Duration, 1000)
+	for i := range ds {
+		ds[i] = time.Duration(i) * 37 * time.Second
+	}
+	limit := func(d *Durafmt) *Durafmt { return d.LimitFirstN(2) }
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FormatAll(ds, InLocale(English), limit)
+	}
+}
@@ -0,0 +1,267 @@
+package durafmt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Locale holds the language specific rules used to format a duration.
+type Locale struct {
+	// Name is the BCP 47 tag of the locale, e.g. "ru".
+	Name string
+	// Units holds the unit names, ordered from years to nanoseconds.
+	Units []string
+	// UnitPlurals holds the forms of Units by plural category, ordered from years to nanoseconds,
+	// e.g. "год", "года" and "лет". Nil or missing forms mean the name of Units for every number,
+	// forms none of which is the name of Units are ignored, so a copy of a locale
+	// with other Units keeps them.
+	UnitPlurals []UnitForms
+	// Compact holds the unit suffixes of the compact style, e.g. "ч" in "2ч".
+	Compact []string
+	// GroupSeparator separates groups of thousands in large numbers.
+	GroupSeparator string
+	// DecimalSeparator separates the integer part of a number from its decimals.
+	DecimalSeparator string
+	// Plural returns the plural category of n.
+	Plural func(n int64) PluralCategory
+	// Words holds the full unit nouns by plural category, ordered from years to nanoseconds.
+	Words []UnitForms
+	// Genders holds the grammatical gender of every unit noun, ordered from years to nanoseconds.
+	Genders []Gender
+	// NumberWords spells n in words agreeing with the gender g and the case gc of the noun it counts,
+	// it returns false if it can not.
+	NumberWords func(n int64, g Gender, gc GrammaticalCase) (string, bool)
+	// Declensions holds Words declined in the other grammatical cases than the nominative.
+	Declensions map[GrammaticalCase][]UnitForms
+	// Fuzzy describes n units of u with the qualifier q, e.g. "почти 2 часа".
+	Fuzzy func(l Locale, q Qualifier, n int64, u Unit) string
+	// Ordinal formats n as an ordinal number of the unit u, e.g. "2-й час".
+	Ordinal func(l Locale, n int64, u Unit) string
+	// Digits holds the ten digits from zero to nine of the numeral system of the locale.
+	// Empty means Latin digits.
+	Digits string
+	// ListSeparator separates the items of a list, ListAnd the last two items.
+	// An empty ListAnd gives a simple comma list.
+	ListSeparator string
+	ListAnd       string
+	// Respectively ends a list matched against another enumeration, e.g. "соответственно".
+	Respectively string
+	// Remaining and Overdue are fmt patterns of the deadline phrases, e.g. "осталось %s".
+	Remaining string
+	Overdue   string
+	// MoreThan and LessThan are fmt patterns of clamped durations, e.g. "более %s".
+	MoreThan string
+	LessThan string
+	// SingleUnits holds the phrases of exactly one unit following MoreThan and LessThan,
+	// ordered from years to nanoseconds, e.g. "года" in "более года".
+	SingleUnits []string
+	// Interval holds the noun of a segment of time by plural category, e.g. "интервал".
+	Interval UnitForms
+	// Segments is the fmt pattern of the number of segments, their noun and their length,
+	// e.g. "%s %s по %s", SegmentsRest the pattern of the time left over, e.g. " и ещё %s".
+	Segments     string
+	SegmentsRest string
+	// Docker holds the phrases of the Docker style.
+	Docker DockerPhrases
+	// Layout is how the displayed units are arranged.
+	Layout Layout
+}
+
+// Layout holds how a locale arranges the displayed units.
+// The zero value is the layout of Russian and English, "2 ч. 30 мин.".
+type Layout struct {
+	// SmallestFirst orders the units from the smallest, "30 мин. 2 ч.".
+	SmallestFirst bool `json:"smallestFirst,omitempty"`
+	// Tight leaves no space between a number and its unit, "2時間".
+	Tight bool `json:"tight,omitempty"`
+	// Joined leaves nothing between the units, "2時間30分".
+	Joined bool `json:"joined,omitempty"`
+	// List joins the units with ListSeparator and ListAnd, "2 hours, 30 minutes and 5 seconds".
+	List bool `json:"list,omitempty"`
+}
+
+// DockerPhrases holds the phrases of the Docker style, see the Docker style.
+type DockerPhrases struct {
+	LessThanASecond string `json:"lessThanASecond,omitempty"` // "Less than a second"
+	AboutAMinute    string `json:"aboutAMinute,omitempty"`    // "About a minute"
+	AboutAnHour     string `json:"aboutAnHour,omitempty"`     // "About an hour"
+	Months          string `json:"months,omitempty"`          // Name of the month unit, "months".
+	Ago             string `json:"ago,omitempty"`             // fmt pattern of a past event, "%s ago".
+}
+
+const (
+	// ArabicIndicDigits are the Eastern Arabic digits.
+	ArabicIndicDigits = "٠١٢٣٤٥٦٧٨٩"
+	// DevanagariDigits are the digits of the Devanagari script.
+	DevanagariDigits = "०१२३४५६७८९"
+)
+
+var (
+	// Russian is the default locale, "12 345 дн.".
+	Russian = Locale{
+		Name:             "ru",
+		Units:            units,
+		UnitPlurals:      russianUnitPlurals,
+		Compact:          unitsCompact,
+		GroupSeparator:   NBSP,
+		DecimalSeparator: ",",
+		Plural:           russianPlural,
+		Words:            russianWords,
+		Declensions:      russianDeclensions,
+		Genders:          russianGenders,
+		NumberWords:      russianNumberWords,
+		Ordinal:          russianOrdinal,
+		Fuzzy:            russianFuzzy,
+		ListSeparator:    ", ",
+		ListAnd:          " и ",
+		Respectively:     "соответственно",
+		Remaining:        "осталось %s",
+		Overdue:          "просрочено на %s",
+		MoreThan:         "более %s",
+		LessThan:         "менее %s",
+		SingleUnits:      []string{"года", "недели", "дня", "часа", "минуты", "секунды", "миллисекунды", "микросекунды", "наносекунды"},
+		Interval:         UnitForms{PluralOne: "интервал", PluralFew: "интервала", PluralMany: "интервалов", PluralOther: "интервала"},
+		Segments:         "%s %s по %s",
+		SegmentsRest:     " и ещё %s",
+		Docker: DockerPhrases{
+			LessThanASecond: "Менее секунды",
+			AboutAMinute:    "Около минуты",
+			AboutAnHour:     "Около часа",
+			Months:          "мес.",
+			Ago:             "%s назад",
+		},
+	}
+
+	// English formats durations the way the upstream durafmt does, "12,345 days".
+	English = Locale{
+		Name:             "en",
+		Units:            unitNames,
+		UnitPlurals:      englishWords,
+		Compact:          []string{"y", "w", "d", "h", "m", "s", "ms", "µs", "ns"},
+		GroupSeparator:   ",",
+		DecimalSeparator: ".",
+		Plural:           englishPlural,
+		Words:            englishWords,
+		NumberWords:      englishNumberWords,
+		Ordinal:          englishOrdinal,
+		Fuzzy:            englishFuzzy,
+		ListSeparator:    ", ",
+		ListAnd:          " and ",
+		Respectively:     "respectively",
+		Remaining:        "%s left",
+		Overdue:          "overdue by %s",
+		MoreThan:         "more than %s",
+		LessThan:         "less than %s",
+		SingleUnits:      englishSingleUnits,
+		Interval:         UnitForms{PluralOne: "interval", PluralOther: "intervals"},
+		Segments:         "%s %s of %s",
+		SegmentsRest:     " and %s more",
+		Docker: DockerPhrases{
+			LessThanASecond: "Less than a second",
+			AboutAMinute:    "About a minute",
+			AboutAnHour:     "About an hour",
+			Months:          "months",
+			Ago:             "%s ago",
+		},
+	}
+)
+
+// LookupLocale returns the locale of the provided BCP 47 tag, registered with RegisterBundle,
+// built-in, "ru" and "en", or compiled from the bundles in locales, e.g. "uk".
+// Unknown tags fall back to their parents, "en-GB" to "en". Tags are case-insensitive.
+func LookupLocale(name string) (Locale, bool) {
+	for tag := name; tag != ""; tag = parentTag(tag) {
+		if l, ok := lookupLocale(tag); ok {
+			return l, true
+		}
+	}
+	return Locale{}, false
+}
+
+// lookupLocale returns the locale of exactly the tag.
+func lookupLocale(tag string) (Locale, bool) {
+	bundlesMu.RLock()
+	l, ok := bundleLocales[strings.ToLower(tag)]
+	bundlesMu.RUnlock()
+	if ok {
+		return l, true
+	}
+	for _, l := range append([]Locale{Russian, English}, generatedLocales...) {
+		if strings.EqualFold(l.Name, tag) {
+			return l, true
+		}
+	}
+	return Locale{}, false
+}
+
+// ResolveLocale returns the locale of the provided BCP 47 tag like LookupLocale,
+// falling back to the default Russian locale, e.g. "ru-RU" → "ru" → Russian.
+func ResolveLocale(name string) Locale {
+	if l, ok := LookupLocale(name); ok {
+		return l
+	}
+	return Russian
+}
+
+// WithLocale sets the locale used to format the output.
+func (d *Durafmt) WithLocale(l Locale) *Durafmt {
+	c := d.Clone()
+	c.locale = &l
+	return c
+}
+
+// WithDigitGrouping sets the output format, separating thousands in large numbers
+// with the separator of the locale, e.g. "12 345 дн.".
+func (d *Durafmt) WithDigitGrouping() *Durafmt {
+	c := d.Clone()
+	c.grouping = true
+	return c
+}
+
+// Locale returns the locale used to format the output.
+func (d *Durafmt) Locale() Locale {
+	if d == nil || d.locale == nil {
+		return Russian
+	}
+	return *d.locale
+}
+
+// formatNumber formats v according to the number options of d.
+func (d *Durafmt) formatNumber(v int64) string {
+	s := strconv.FormatInt(v, 10)
+	if d.grouping {
+		s = groupDigits(s, d.Locale().GroupSeparator)
+	}
+	return TransliterateDigits(s, d.Locale().Digits)
+}
+
+// TransliterateDigits replaces the Latin digits of s with the digits of another numeral system,
+// given as the ten digits from zero to nine. Empty digits leave s untouched.
+func TransliterateDigits(s, digits string) string {
+	numerals := []rune(digits)
+	if len(numerals) != 10 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return numerals[r-'0']
+		}
+		return r
+	}, s)
+}
+
+// groupDigits inserts sep between every group of three digits of s.
+func groupDigits(s, sep string) string {
+	if len(s) <= 3 || sep == "" {
+		return s
+	}
+	head := len(s) % 3
+	if head == 0 {
+		head = 3
+	}
+	grouped := s[:head]
+	for i := head; i < len(s); i += 3 {
+		grouped += sep + s[i:i+3]
+	}
+	return grouped
+}
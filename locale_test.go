@@ -0,0 +1,166 @@
+package durafmt
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWithLocale(t *testing.T) {
+	testTimesWithLocale := []struct {
+		test     time.Duration
+		locale   Locale
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, Russian, "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, English, "2 hours 30 minutes"},
+		{time.Hour + time.Minute, English, "1 hour 1 minute"},
+		{-100 * time.Second, English, "-1 minute 40 seconds"},
+	}
+
+	for _, table := range testTimesWithLocale {
+		result := Parse(table.test).WithLocale(table.locale).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithLocale(%s).String() got %q, expected %q",
+				table.test, table.locale.Name, result, table.expected)
+		}
+	}
+}
+
+func TestParseWithLayout(t *testing.T) {
+	japanese := English
+	japanese.Units = []string{"年", "週間", "日", "時間", "分", "秒", "ミリ秒", "マイクロ秒", "ナノ秒"}
+	japanese.Layout = Layout{Tight: true, Joined: true}
+	smallestFirst := Russian
+	smallestFirst.Layout = Layout{SmallestFirst: true}
+	list := English
+	list.Layout = Layout{List: true}
+
+	testTimesWithLayout := []struct {
+		test     time.Duration
+		locale   Locale
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, japanese, "2時間30分"},
+		{-90 * time.Minute, japanese, "-1時間30分"},
+		{2*time.Hour + 30*time.Minute, smallestFirst, "30 мин. 2 ч."},
+		{2*time.Hour + 30*time.Minute + 5*time.Second, list, "2 hours, 30 minutes and 5 seconds"},
+		{2 * time.Hour, list, "2 hours"},
+	}
+
+	for _, table := range testTimesWithLayout {
+		d := Parse(table.test).WithLocale(table.locale)
+		if result := d.String(); result != table.expected {
+			t.Errorf("Parse(%q).WithLocale(%v).String() got %q, expected %q",
+				table.test, table.locale.Layout, result, table.expected)
+		}
+		var b strings.Builder
+		if _, err := d.WriteTo(&b); err != nil || b.String() != table.expected {
+			t.Errorf("Parse(%q).WithLocale(%v).WriteTo() got %q, expected %q",
+				table.test, table.locale.Layout, b.String(), table.expected)
+		}
+	}
+}
+
+func TestParseWithDigitGrouping(t *testing.T) {
+	testTimesWithGrouping := []struct {
+		test      time.Duration
+		limitUnit string
+		locale    Locale
+		expected  string
+	}{
+		{12345 * 24 * time.Hour, DaysKey, Russian, "12\u00a0345 дн."},
+		{12345 * 24 * time.Hour, DaysKey, English, "12,345 days"},
+		{1234567 * time.Second, SecondsKey, Russian, "1\u00a0234\u00a0567 сек."},
+		{999 * time.Second, SecondsKey, English, "999 seconds"},
+		{1000 * time.Second, SecondsKey, English, "1,000 seconds"},
+	}
+
+	for _, table := range testTimesWithGrouping {
+		result := Parse(table.test).LimitToUnit(table.limitUnit).WithLocale(table.locale).WithDigitGrouping().String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithDigitGrouping().String() got %q, expected %q",
+				table.test, result, table.expected)
+		}
+	}
+}
+
+func TestParseWithDigits(t *testing.T) {
+	arabic := English
+	arabic.Name = "ar"
+	arabic.Digits = ArabicIndicDigits
+
+	hindi := English
+	hindi.Name = "hi"
+	hindi.Digits = DevanagariDigits
+
+	testTimesWithDigits := []struct {
+		test     time.Duration
+		locale   Locale
+		expected string
+	}{
+		{12*time.Hour + 30*time.Minute, Russian, "12 ч. 30 мин."},
+		{12*time.Hour + 30*time.Minute, arabic, "١٢ hours ٣٠ minutes"},
+		{12*time.Hour + 30*time.Minute, hindi, "१२ hours ३० minutes"},
+	}
+
+	for _, table := range testTimesWithDigits {
+		result := Parse(table.test).WithLocale(table.locale).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithLocale(%s).String() got %q, expected %q",
+				table.test, table.locale.Name, result, table.expected)
+		}
+	}
+}
+
+func TestTransliterateDigits(t *testing.T) {
+	testStrings := []struct {
+		test     string
+		digits   string
+		expected string
+	}{
+		{"1,024", "", "1,024"},
+		{"1,024", ArabicIndicDigits, "١,٠٢٤"},
+		{"1,024", "0123", "1,024"},
+	}
+
+	for _, table := range testStrings {
+		if result := TransliterateDigits(table.test, table.digits); result != table.expected {
+			t.Errorf("TransliterateDigits(%q, %q) got %q, expected %q",
+				table.test, table.digits, result, table.expected)
+		}
+	}
+}
+
+func TestLocaleUnitPlurals(t *testing.T) {
+	year := 365 * 24 * time.Hour
+	abbreviated := English
+	abbreviated.Units = []string{"yrs", "wks", "days", "hrs", "mins", "secs", "ms", "µs", "ns"}
+	abbreviated.UnitPlurals = make([]UnitForms, 9)
+	abbreviated.UnitPlurals[Hours] = UnitForms{PluralOne: "hr", PluralOther: "hrs"}
+	abbreviated.UnitPlurals[Seconds] = UnitForms{PluralOne: "sec", PluralOther: "secs"}
+
+	testUnits := []struct {
+		d        *Durafmt
+		expected string
+	}{
+		{Parse(time.Hour + time.Second).WithLocale(English), "1 hour 1 second"},
+		{Parse(21 * time.Hour).WithLocale(English), "21 hours"},
+		{Parse(time.Millisecond).WithLocale(abbreviated), "1 ms"},
+		{Parse(time.Hour + time.Second).WithLocale(abbreviated), "1 hr 1 sec"},
+		{Parse(2*time.Hour + 2*time.Second).WithLocale(abbreviated), "2 hrs 2 secs"},
+		{Parse(year), "1 год"},
+		{Parse(2 * year), "2 года"},
+		{Parse(5 * year), "5 лет"},
+		{Parse(21 * year), "21 год"},
+		{Parse(year+year/2).RoundTo(Years, RoundTenths), "1,5 года"},
+		{Parse(year + time.Hour), "1 год 1 ч."},
+		{Parse(time.Hour).WithSutki(), "1 ч."},
+		{Parse(24 * time.Hour).WithSutki(), "1 сут."},
+	}
+	for _, table := range testUnits {
+		if result := table.d.String(); result != table.expected {
+			t.Errorf("Parse(%s).String() got %q, expected %q", table.d.Duration(), result, table.expected)
+		}
+	}
+}
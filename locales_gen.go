@@ -0,0 +1,55 @@
+// Code generated by durafmt-localegen from locales/uk.json; DO NOT EDIT.
+
+package durafmt
+
+// Ukrainian is the "uk" locale compiled from locales/uk.json.
+var Ukrainian = Locale{
+	Name:             "uk",
+	Units:            []string{"р.", "тиж.", "дн.", "год.", "хв.", "сек.", "мс", "мкс", "нс"},
+	Compact:          []string{"р", "т", "д", "г", "х", "с", "мс", "мкс", "нс"},
+	SingleUnits:      []string{"року", "тижня", "дня", "години", "хвилини", "секунди", "мілісекунди", "мікросекунди", "наносекунди"},
+	GroupSeparator:   "\u00a0",
+	DecimalSeparator: ",",
+	ListSeparator:    ", ",
+	ListAnd:          " і ",
+	Respectively:     "відповідно",
+	Remaining:        "залишилося %s",
+	Overdue:          "прострочено на %s",
+	MoreThan:         "більше %s",
+	LessThan:         "менше %s",
+	Segments:         "%s %s по %s",
+	SegmentsRest:     " і ще %s",
+	Plural:           ukrainianPlural,
+	Words: []UnitForms{
+		{PluralOne: "рік", PluralFew: "роки", PluralMany: "років", PluralOther: "року"},
+		{PluralOne: "тиждень", PluralFew: "тижні", PluralMany: "тижнів", PluralOther: "тижня"},
+		{PluralOne: "день", PluralFew: "дні", PluralMany: "днів", PluralOther: "дня"},
+		{PluralOne: "година", PluralFew: "години", PluralMany: "годин", PluralOther: "години"},
+		{PluralOne: "хвилина", PluralFew: "хвилини", PluralMany: "хвилин", PluralOther: "хвилини"},
+		{PluralOne: "секунда", PluralFew: "секунди", PluralMany: "секунд", PluralOther: "секунди"},
+		{PluralOne: "мілісекунда", PluralFew: "мілісекунди", PluralMany: "мілісекунд", PluralOther: "мілісекунди"},
+		{PluralOne: "мікросекунда", PluralFew: "мікросекунди", PluralMany: "мікросекунд", PluralOther: "мікросекунди"},
+		{PluralOne: "наносекунда", PluralFew: "наносекунди", PluralMany: "наносекунд", PluralOther: "наносекунди"},
+	},
+	Docker:   DockerPhrases{LessThanASecond: "Менше секунди", AboutAMinute: "Близько хвилини", AboutAnHour: "Близько години", Months: "міс.", Ago: "%s тому"},
+	Interval: UnitForms{PluralOne: "інтервал", PluralFew: "інтервали", PluralMany: "інтервалів", PluralOther: "інтервалу"},
+}
+
+// ukrainianPlural returns the plural category of n in the "uk" locale.
+func ukrainianPlural(n int64) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case (n%10 >= 2 && n%10 <= 4) && !(n%100 >= 12 && n%100 <= 14):
+		return PluralFew
+	case n%10 == 0 || (n%10 >= 5 && n%10 <= 9) || (n%100 >= 11 && n%100 <= 14):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// generatedLocales are the locales compiled from bundles, found by LookupLocale.
+var generatedLocales = []Locale{Ukrainian}
@@ -0,0 +1,45 @@
+package durafmt
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	romanValues  = []int64{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	romanSymbols = []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	superscripts = strings.NewReplacer("0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+		"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹")
+)
+
+// RomanNumerals renders numbers as Roman numerals, e.g. "XIV ч.", for WithNumberRenderer.
+// Numbers out of the range 1 to 3999 are rendered with Latin digits.
+func RomanNumerals(u Unit, v int64) string {
+	if v < 1 || v > 3999 {
+		return strconv.FormatInt(v, 10)
+	}
+	var b strings.Builder
+	for i, value := range romanValues {
+		for ; v >= value; v -= value {
+			b.WriteString(romanSymbols[i])
+		}
+	}
+	return b.String()
+}
+
+// Superscript renders numbers with superscript digits, e.g. "¹⁴ ч.", for WithNumberRenderer.
+func Superscript(u Unit, v int64) string {
+	return superscripts.Replace(strconv.FormatInt(v, 10))
+}
+
+// ZeroPadded returns a renderer for WithNumberRenderer padding numbers with zeros up to width digits,
+// e.g. "05 мин.".
+func ZeroPadded(width int) func(u Unit, v int64) string {
+	return func(u Unit, v int64) string {
+		s := strconv.FormatInt(v, 10)
+		if n := width - len(s); n > 0 {
+			s = strings.Repeat("0", n) + s
+		}
+		return s
+	}
+}
@@ -0,0 +1,28 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWithNumberRenderer(t *testing.T) {
+	testNumberRenderer := []struct {
+		test     time.Duration
+		render   func(u Unit, v int64) string
+		expected string
+	}{
+		{14*time.Hour + 9*time.Minute, RomanNumerals, "XIV ч. IX мин."},
+		{1994 * 24 * time.Hour, RomanNumerals, "V лет XXIV нед. I дн."},
+		{14*time.Hour + 5*time.Minute, Superscript, "¹⁴ ч. ⁵ мин."},
+		{2*time.Hour + 5*time.Minute, ZeroPadded(2), "02 ч. 05 мин."},
+		{-5 * time.Minute, ZeroPadded(3), "-005 мин."},
+		{2 * time.Hour, nil, "2 ч."},
+	}
+
+	for _, table := range testNumberRenderer {
+		result := Parse(table.test).WithNumberRenderer(table.render).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithNumberRenderer().String() got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package durafmt
+
+import "strings"
+
+// Gender is the grammatical gender of a unit noun, numerals and ordinals agree with it.
+type Gender int
+
+const (
+	Masculine Gender = iota
+	Feminine
+	Neuter
+	// PluraleTantum is a noun used in the plural only, e.g. "сутки".
+	PluraleTantum
+)
+
+// WithNumberWords sets the output format to numbers spelled out in words followed by
+// full unit words, e.g. "одна минута", "два часа тридцать минут".
+// Locales without number words, and grammatical cases they do not support, keep digits.
+func (d *Durafmt) WithNumberWords() *Durafmt {
+	c := d.Clone()
+	c.words = true
+	c.numberWords = true
+	return c
+}
+
+// gender returns the grammatical gender of the unit u, Masculine if unknown.
+func (l Locale) gender(u Unit) Gender {
+	if int(u) < len(l.Genders) {
+		return l.Genders[u]
+	}
+	return Masculine
+}
+
+var (
+	russianOnes = [][]string{
+		{"ноль", "один", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+		{"ноль", "одна", "две", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+		{"ноль", "одно", "два", "три", "четыре", "пять", "шесть", "семь", "восемь", "девять"},
+		{"ноль", "одни", "двое", "трое", "четверо", "пять", "шесть", "семь", "восемь", "девять"},
+	}
+	russianTeens    = []string{"десять", "одиннадцать", "двенадцать", "тринадцать", "четырнадцать", "пятнадцать", "шестнадцать", "семнадцать", "восемнадцать", "девятнадцать"}
+	russianTens     = []string{"", "", "двадцать", "тридцать", "сорок", "пятьдесят", "шестьдесят", "семьдесят", "восемьдесят", "девяносто"}
+	russianHundreds = []string{"", "сто", "двести", "триста", "четыреста", "пятьсот", "шестьсот", "семьсот", "восемьсот", "девятьсот"}
+
+	// russianScales holds the thousands, millions and so on with their gender.
+	russianScales = []struct {
+		forms  UnitForms
+		gender Gender
+	}{
+		{UnitForms{PluralOne: "тысяча", PluralFew: "тысячи", PluralMany: "тысяч"}, Feminine},
+		{UnitForms{PluralOne: "миллион", PluralFew: "миллиона", PluralMany: "миллионов"}, Masculine},
+		{UnitForms{PluralOne: "миллиард", PluralFew: "миллиарда", PluralMany: "миллиардов"}, Masculine},
+		{UnitForms{PluralOne: "триллион", PluralFew: "триллиона", PluralMany: "триллионов"}, Masculine},
+		{UnitForms{PluralOne: "квадриллион", PluralFew: "квадриллиона", PluralMany: "квадриллионов"}, Masculine},
+		{UnitForms{PluralOne: "квинтиллион", PluralFew: "квинтиллиона", PluralMany: "квинтиллионов"}, Masculine},
+	}
+
+	englishOnes  = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScale = []string{"thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// splitTriads splits n into groups of three digits, the least significant first.
+func splitTriads(n int64) []int64 {
+	var triads []int64
+	for ; n > 0; n /= 1000 {
+		triads = append(triads, n%1000)
+	}
+	return triads
+}
+
+// russianNumberWords spells n in Russian, agreeing with the gender g of the noun it counts.
+// Only the nominative and the accusative are supported.
+func russianNumberWords(n int64, g Gender, gc GrammaticalCase) (string, bool) {
+	if n < 0 || (gc != Nominative && gc != Accusative) {
+		return "", false
+	}
+	if n == 0 {
+		return russianOnes[g][0], true
+	}
+	// collective numerals do not combine: "двадцать двое суток" is not said, digits are used.
+	if g == PluraleTantum && n > 20 && n%100/10 != 1 && n%10 >= 2 && n%10 <= 4 {
+		return "", false
+	}
+
+	var words []string
+	triads := splitTriads(n)
+	for i := len(triads) - 1; i >= 0; i-- {
+		triad := triads[i]
+		if triad == 0 {
+			continue
+		}
+		gender := g
+		if i > 0 {
+			gender = russianScales[i-1].gender
+		}
+		words = append(words, russianTriad(triad, gender)...)
+		if i > 0 {
+			words = append(words, russianScales[i-1].forms.Form(russianPlural(triad)))
+		}
+	}
+
+	// feminine one and thousand decline in the accusative: "одну минуту", "тысячу".
+	if gc == Accusative {
+		for i, w := range words {
+			switch w {
+			case "одна":
+				words[i] = "одну"
+			case "тысяча":
+				words[i] = "тысячу"
+			}
+		}
+	}
+	return strings.Join(words, " "), true
+}
+
+// russianTriad spells a number from 1 to 999 in Russian.
+func russianTriad(n int64, g Gender) []string {
+	var words []string
+	if n >= 100 {
+		words = append(words, russianHundreds[n/100])
+		n %= 100
+	}
+	switch {
+	case n >= 20:
+		words = append(words, russianTens[n/10])
+		if n%10 > 0 {
+			words = append(words, russianOnes[g][n%10])
+		}
+	case n >= 10:
+		words = append(words, russianTeens[n-10])
+	case n > 0:
+		words = append(words, russianOnes[g][n])
+	}
+	return words
+}
+
+// englishNumberWords spells n in English, e.g. "one hundred twenty-three".
+func englishNumberWords(n int64, g Gender, gc GrammaticalCase) (string, bool) {
+	if n < 0 {
+		return "", false
+	}
+	if n == 0 {
+		return englishOnes[0], true
+	}
+
+	var words []string
+	triads := splitTriads(n)
+	for i := len(triads) - 1; i >= 0; i-- {
+		triad := triads[i]
+		if triad == 0 {
+			continue
+		}
+		if triad >= 100 {
+			words = append(words, englishOnes[triad/100], "hundred")
+			triad %= 100
+		}
+		switch {
+		case triad >= 20 && triad%10 > 0:
+			words = append(words, englishTens[triad/10]+"-"+englishOnes[triad%10])
+		case triad >= 20:
+			words = append(words, englishTens[triad/10])
+		case triad > 0:
+			words = append(words, englishOnes[triad])
+		}
+		if i > 0 {
+			words = append(words, englishScale[i-1])
+		}
+	}
+	return strings.Join(words, " "), true
+}
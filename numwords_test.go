@@ -0,0 +1,59 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWithNumberWords(t *testing.T) {
+	testTimesWithWords := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(time.Minute), "одна минута"},
+		{Parse(time.Second), "одна секунда"},
+		{Parse(time.Hour), "один час"},
+		{Parse(24 * time.Hour), "один день"},
+		{Parse(168 * time.Hour), "одна неделя"},
+		{Parse(2*time.Hour + 2*time.Minute), "два часа две минуты"},
+		{Parse(21*time.Hour + 30*time.Minute), "двадцать один час тридцать минут"},
+		{Parse(time.Minute).WithGrammaticalCase(Accusative).WithNumberWords(), "одну минуту"},
+		{Parse(2 * time.Hour).WithGrammaticalCase(Genitive).WithNumberWords(), "2 часов"},
+		{Parse(1234 * time.Second).LimitToUnit(SecondsKey), "одна тысяча двести тридцать четыре секунды"},
+		{Parse(2001 * time.Second).LimitToUnit(SecondsKey), "две тысячи одна секунда"},
+		{Parse(2 * time.Hour).WithCase(Title).WithLocale(Russian), "Два Часа"},
+		{Parse(121*time.Hour + time.Minute).LimitToUnit(HoursKey).WithLocale(English), "one hundred twenty-one hours one minute"},
+	}
+
+	for _, table := range testTimesWithWords {
+		if result := table.test.WithNumberWords().String(); result != table.expected {
+			t.Errorf("WithNumberWords().String() got %q, expected %q", result, table.expected)
+		}
+	}
+
+	if result := Capitalize(Parse(2 * time.Hour).WithNumberWords().String()); result != "Два часа" {
+		t.Errorf("Capitalize(WithNumberWords().String()) got %q, expected %q", result, "Два часа")
+	}
+}
+
+func TestRussianNumberWords(t *testing.T) {
+	testNumbers := []struct {
+		test     int64
+		gender   Gender
+		expected string
+	}{
+		{0, Masculine, "ноль"},
+		{1, Neuter, "одно"},
+		{12, Feminine, "двенадцать"},
+		{40, Masculine, "сорок"},
+		{1000000, Masculine, "один миллион"},
+		{5000, Masculine, "пять тысяч"},
+		{999, Feminine, "девятьсот девяносто девять"},
+	}
+
+	for _, table := range testNumbers {
+		if result, _ := russianNumberWords(table.test, table.gender, Nominative); result != table.expected {
+			t.Errorf("russianNumberWords(%d) got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
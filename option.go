@@ -0,0 +1,58 @@
+package durafmt
+
+import "time"
+
+// Option sets the output format of a Durafmt, e.g.
+//
+//	func(d *Durafmt) *Durafmt { return d.LimitFirstN(2) }
+type Option func(d *Durafmt) *Durafmt
+
+// Apply returns d with all the options applied in order.
+func (d *Durafmt) Apply(opts ...Option) *Durafmt {
+	for _, opt := range opts {
+		d = opt(d)
+	}
+	return d
+}
+
+// InLocale returns the option setting the locale l, see WithLocale.
+func InLocale(l Locale) Option {
+	return func(d *Durafmt) *Durafmt { return d.WithLocale(l) }
+}
+
+// Options is the output format set at once by New, for declarative configuration.
+// The fields encode to JSON, so formatter settings can be stored and shared.
+type Options struct {
+	// Locale is the BCP 47 tag of a locale, see LookupLocale, e.g. "en" or "en-GB".
+	// Unknown tags and "" mean Russian.
+	Locale string `json:"locale,omitempty"`
+	// MaxUnit is the biggest unit, as set by LimitTo. Years means no restriction.
+	MaxUnit Unit `json:"maxUnit,omitempty"`
+	// MinUnit is the smallest unit, the duration is rounded to it with Rounding.
+	// Years means no restriction.
+	MinUnit Unit `json:"minUnit,omitempty"`
+	// LimitN is the number of first units to output, as set by LimitFirstN. 0 means no limit.
+	LimitN int `json:"limitN,omitempty"`
+	// Separator is placed between every number and its unit, as set by WithUnitSeparator.
+	Separator string `json:"separator,omitempty"`
+	// Width pads every number with spaces, as set by WithWidth.
+	Width int `json:"width,omitempty"`
+	// Rounding is how the duration is rounded to MinUnit.
+	Rounding RoundingMode `json:"rounding,omitempty"`
+}
+
+// New creates a new *Durafmt struct formatting the duration with the options.
+func New(dinput time.Duration, opts Options) *Durafmt {
+	d := Parse(dinput).
+		LimitTo(opts.MaxUnit).
+		LimitFirstN(opts.LimitN).
+		WithUnitSeparator(opts.Separator).
+		WithWidth(opts.Width)
+	if l, ok := LookupLocale(opts.Locale); ok {
+		d = d.WithLocale(l)
+	}
+	if opts.MinUnit > Years {
+		d = d.RoundTo(opts.MinUnit, opts.Rounding).DropBelow(opts.MinUnit)
+	}
+	return d
+}
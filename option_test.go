@@ -0,0 +1,47 @@
+package durafmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	duration := 26*time.Hour + 40*time.Minute + 30*time.Second
+
+	testNew := []struct {
+		opts     Options
+		expected string
+	}{
+		{Options{}, "1 дн. 2 ч. 40 мин. 30 сек."},
+		{Options{Locale: "en", LimitN: 2}, "1 day 2 hours"},
+		{Options{MaxUnit: Hours, MinUnit: Minutes}, "26 ч. 41 мин."},
+		{Options{MinUnit: Minutes, Rounding: RoundDown}, "1 дн. 2 ч. 40 мин."},
+		{Options{MinUnit: Hours, Rounding: RoundTenths, Locale: "en"}, "26.7 hours"},
+		{Options{Separator: NBSP, Width: 3, LimitN: 1}, "  1 дн."},
+		{Options{Locale: "xx", LimitN: 1}, "1 дн."},
+	}
+
+	for _, table := range testNew {
+		if result := New(duration, table.opts).String(); result != table.expected {
+			t.Errorf("New(%v) got %q, expected %q", table.opts, result, table.expected)
+		}
+	}
+}
+
+func TestOptionsJSON(t *testing.T) {
+	opts := Options{Locale: "en", MaxUnit: Hours, MinUnit: Seconds, LimitN: 2}
+	data, err := json.Marshal(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"locale":"en","maxUnit":"hours","minUnit":"seconds","limitN":2}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal(Options) got %s, expected %s", data, expected)
+	}
+
+	var decoded Options
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != opts {
+		t.Errorf("json.Unmarshal(%s) got %v, %v, expected %v", data, decoded, err, opts)
+	}
+}
@@ -0,0 +1,45 @@
+package durafmt
+
+import "strconv"
+
+// russianOrdinals holds the ordinal suffixes by the grammatical gender of the unit noun.
+var russianOrdinals = []string{Masculine: "-й", Feminine: "-я", Neuter: "-е", PluraleTantum: "-е"}
+
+// Ordinal formats the biggest displayed unit as an ordinal number, e.g. "2-й час" or "3-я минута",
+// for countdown narrations and schedule descriptions. It returns "" for a zero duration.
+func (d *Durafmt) Ordinal() string {
+	for _, c := range d.components() {
+		if c.value == 0 {
+			continue
+		}
+		locale := d.Locale()
+		if locale.Ordinal == nil {
+			return ""
+		}
+		return applyCase(locale.Ordinal(locale, c.value, c.unit), d.letterCase)
+	}
+	return ""
+}
+
+// russianOrdinal formats n as a Russian ordinal of the unit u, e.g. "3-я минута".
+func russianOrdinal(l Locale, n int64, u Unit) string {
+	return strconv.FormatInt(n, 10) + russianOrdinals[l.gender(u)] + " " + l.word(u, PluralOne, Nominative)
+}
+
+// englishOrdinal formats n as an English ordinal of the unit u, e.g. "3rd minute".
+func englishOrdinal(l Locale, n int64, u Unit) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.FormatInt(n, 10) + suffix + " " + l.word(u, PluralOne, Nominative)
+}
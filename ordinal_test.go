@@ -0,0 +1,31 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrdinal(t *testing.T) {
+	testTimesOrdinal := []struct {
+		test     time.Duration
+		locale   Locale
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, Russian, "2-й час"},
+		{3*time.Minute + 5*time.Second, Russian, "3-я минута"},
+		{24 * time.Hour, Russian, "1-й день"},
+		{2 * 168 * time.Hour, Russian, "2-я неделя"},
+		{0, Russian, ""},
+		{2 * time.Hour, English, "2nd hour"},
+		{11 * time.Minute, English, "11th minute"},
+		{21 * time.Second, English, "21st second"},
+		{3 * time.Millisecond, English, "3rd millisecond"},
+	}
+
+	for _, table := range testTimesOrdinal {
+		result := Parse(table.test).WithLocale(table.locale).Ordinal()
+		if result != table.expected {
+			t.Errorf("Parse(%q).Ordinal() got %q, expected %q", table.test, result, table.expected)
+		}
+	}
+}
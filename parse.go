@@ -0,0 +1,103 @@
+package durafmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// goUnitOrder ranks the units of time.ParseDuration from the biggest to the smallest.
+var goUnitOrder = map[string]int{"h": 0, "m": 1, "s": 2, "ms": 3, "us": 4, "µs": 4, "μs": 4, "ns": 5}
+
+// token is a single number and unit of a duration string, e.g. "1.5" and "h".
+type token struct {
+	number string
+	unit   string
+}
+
+// tokenize splits a duration string without its sign into numbers and units.
+// It reports false if the string is not a sequence of numbers followed by units.
+func tokenize(s string) ([]token, bool) {
+	var tokens []token
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] == '.' || '0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+		j := i
+		for j < len(s) && s[j] != '.' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		if i == 0 || j == i {
+			return nil, false
+		}
+		tokens = append(tokens, token{number: s[:i], unit: s[i:j]})
+		s = s[j:]
+	}
+	return tokens, len(tokens) > 0
+}
+
+// normalize removes the spaces, Unicode ones included, and the commas following
+// a unit from a duration string, so "2h, 30m" becomes "2h30m".
+// Commas between digits are kept, "1,5h" is not a valid duration.
+func normalize(input string) string {
+	var b strings.Builder
+	b.Grow(len(input))
+	var prev rune
+	for _, r := range input {
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r == ',' && unicode.IsLetter(prev):
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+// ParseStrict creates a new *Durafmt struct from a string, rejecting any input
+// whose meaning is not obvious: units must go from the biggest to the smallest
+// without repeating, only the last number may have a fraction, and nothing
+// may precede or follow the duration, e.g. "1h30m" is accepted, "30m1h" is not.
+func ParseStrict(input string) (*Durafmt, error) {
+	tokens, ok := tokenize(strings.TrimPrefix(strings.TrimPrefix(input, "-"), "+"))
+	if !ok {
+		return nil, errors.New("durafmt: invalid duration " + strconv.Quote(input))
+	}
+	last := -1
+	for i, t := range tokens {
+		order, ok := goUnitOrder[t.unit]
+		if !ok {
+			return nil, errors.New("durafmt: unknown unit " + strconv.Quote(t.unit) + " in duration " + strconv.Quote(input))
+		}
+		if order <= last {
+			return nil, errors.New("durafmt: unit " + strconv.Quote(t.unit) + " out of order in duration " + strconv.Quote(input))
+		}
+		if strings.Contains(t.number, ".") && i < len(tokens)-1 {
+			return nil, errors.New("durafmt: fraction before the last unit in duration " + strconv.Quote(input))
+		}
+		last = order
+	}
+	return ParseString(input)
+}
+
+// ParseLenient creates a new *Durafmt struct from a string, accepting everything
+// ParseString does and also:
+//   - units in any letter case, "1H30M";
+//   - a bare number, meaning seconds, "90";
+//   - repeated and unordered units, which add up, "30m1h30m" is 2 hours.
+func ParseLenient(input string) (*Durafmt, error) {
+	s := strings.ToLower(normalize(input))
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		s += "s"
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, err
+	}
+	return &Durafmt{duration: duration, input: s, cache: new(formatCache)}, nil
+}
@@ -0,0 +1,87 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStrict(t *testing.T) {
+	testParseStrict := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{"1h30m", 90 * time.Minute, false},
+		{"-2m3.5s", -(2*time.Minute + 3500*time.Millisecond), false},
+		{"1.5h", 90 * time.Minute, false},
+		{"30m1h", 0, true},
+		{"1h1h", 0, true},
+		{"1.5h30m", 0, true},
+		{"1h30m ", 0, true},
+		{"1h30x", 0, true},
+		{"0", 0, true},
+	}
+
+	for _, table := range testParseStrict {
+		d, err := ParseStrict(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParseStrict(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParseStrict(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	testParseLenient := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{" 1h30m ", 90 * time.Minute, false},
+		{"1H30M", 90 * time.Minute, false},
+		{"90", 90 * time.Second, false},
+		{"0", 0, false},
+		{"30m1h30m", 2 * time.Hour, false},
+		{"1h30x", 0, true},
+	}
+
+	for _, table := range testParseLenient {
+		d, err := ParseLenient(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParseLenient(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParseLenient(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
+
+func TestParseStringWhitespace(t *testing.T) {
+	testParseStringWhitespace := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{"2h 30m", 150 * time.Minute, false},
+		{" -2h 30m ", -150 * time.Minute, false},
+		{"2h, 30m", 150 * time.Minute, false},
+		{"2h, 30m", 150 * time.Minute, false},
+		{"2h 30m\t10s", 150*time.Minute + 10*time.Second, false},
+		{"1,5h", 0, true},
+	}
+
+	for _, table := range testParseStringWhitespace {
+		d, err := ParseString(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParseString(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParseString(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
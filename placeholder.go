@@ -0,0 +1,23 @@
+package durafmt
+
+import "sync"
+
+var (
+	placeholderMu  sync.RWMutex
+	nilPlaceholder string
+)
+
+// SetNilPlaceholder sets the output of a nil *Durafmt, "" by default,
+// e.g. "—" for optional durations shown in tables.
+func SetNilPlaceholder(s string) {
+	placeholderMu.Lock()
+	defer placeholderMu.Unlock()
+	nilPlaceholder = s
+}
+
+// NilPlaceholder returns the output of a nil *Durafmt, see SetNilPlaceholder.
+func NilPlaceholder() string {
+	placeholderMu.RLock()
+	defer placeholderMu.RUnlock()
+	return nilPlaceholder
+}
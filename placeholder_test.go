@@ -0,0 +1,64 @@
+package durafmt
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNilDurafmt(t *testing.T) {
+	var d *Durafmt
+
+	if result := d.String(); result != "" {
+		t.Errorf("nil String() got %q, expected %q", result, "")
+	}
+	if result := d.Duration(); result != 0 {
+		t.Errorf("nil Duration() got %s, expected 0s", result)
+	}
+	if result := d.Parts(); result != nil {
+		t.Errorf("nil Parts() got %q, expected none", result)
+	}
+	if result := d.Units(); len(result) != 0 {
+		t.Errorf("nil Units() got %v, expected none", result)
+	}
+	if result := d.Timecode(25); result != "00:00:00:00" {
+		t.Errorf("nil Timecode() got %q, expected %q", result, "00:00:00:00")
+	}
+	if result := d.Locale().Name; result != Russian.Name {
+		t.Errorf("nil Locale() got %q, expected %q", result, Russian.Name)
+	}
+	if result := d.Add(time.Minute).WithLocale(English).String(); result != "1 minute" {
+		t.Errorf("nil Add() got %q, expected %q", result, "1 minute")
+	}
+
+	SetNilPlaceholder("—")
+	defer SetNilPlaceholder("")
+	if result := fmt.Sprintf("%v", d); result != "—" {
+		t.Errorf("nil Sprintf() got %q, expected %q", result, "—")
+	}
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil || buf.String() != "—" {
+		t.Errorf("nil WriteTo() got %q %v, expected %q", buf.String(), err, "—")
+	}
+}
+
+func TestZeroDurafmt(t *testing.T) {
+	var d Durafmt
+
+	testZero := []struct {
+		test     string
+		expected string
+	}{
+		{d.String(), ""},
+		{d.LimitFirstN(2).String(), ""},
+		{d.WithLocale(English).String(), ""},
+		{d.Add(90 * time.Second).String(), "1 мин. 30 сек."},
+		{fmt.Sprint(d.Parts()), "[]"},
+	}
+	for _, table := range testZero {
+		if table.test != table.expected {
+			t.Errorf("Durafmt{} got %q, expected %q", table.test, table.expected)
+		}
+	}
+}
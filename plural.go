@@ -0,0 +1,74 @@
+package durafmt
+
+// PluralCategory is a CLDR plural category, selecting the form of a noun following a number.
+type PluralCategory int
+
+const (
+	PluralOther PluralCategory = iota
+	PluralOne
+	PluralFew
+	PluralMany
+	PluralZero
+	PluralTwo
+)
+
+var (
+	pluralCategoryNames = []string{
+		PluralOther: "other", PluralOne: "one", PluralFew: "few", PluralMany: "many", PluralZero: "zero", PluralTwo: "two",
+	}
+	// pluralOrder is the CLDR order of the categories having rules.
+	pluralOrder = []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany}
+)
+
+// String returns the CLDR name of the category, e.g. "few".
+func (c PluralCategory) String() string {
+	if c < 0 || int(c) >= len(pluralCategoryNames) {
+		return "unknown"
+	}
+	return pluralCategoryNames[c]
+}
+
+// parsePluralCategory returns the category of the CLDR name, e.g. "few".
+func parsePluralCategory(name string) (PluralCategory, bool) {
+	for c, n := range pluralCategoryNames {
+		if n == name {
+			return PluralCategory(c), true
+		}
+	}
+	return PluralOther, false
+}
+
+// UnitForms holds the forms of a unit noun by plural category,
+// e.g. {PluralOne: "час", PluralFew: "часа", PluralMany: "часов"}.
+type UnitForms map[PluralCategory]string
+
+// Form returns the form of the category c, falling back to PluralOther.
+func (f UnitForms) Form(c PluralCategory) string {
+	if form, ok := f[c]; ok {
+		return form
+	}
+	return f[PluralOther]
+}
+
+// russianPlural returns the Russian plural category of n: 1 час, 2 часа, 5 часов.
+func russianPlural(n int64) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+// englishPlural returns the English plural category of n: 1 hour, 2 hours.
+func englishPlural(n int64) PluralCategory {
+	if n == 1 || n == -1 {
+		return PluralOne
+	}
+	return PluralOther
+}
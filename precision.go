@@ -0,0 +1,28 @@
+package durafmt
+
+// Precision is a named level of detail of the output, set by WithPrecision.
+type Precision int
+
+const (
+	// Fine shows the full breakdown of the duration. This is the default.
+	Fine Precision = iota
+	// Normal shows the first 2 units, "2 ч. 30 мин.".
+	Normal
+	// Coarse shows only the biggest unit, rounded half up, "3 ч." for 2h40m.
+	Coarse
+)
+
+// WithPrecision sets the output format to a named level of detail, so the same levels
+// are used across an application instead of scattered LimitFirstN calls.
+// It replaces the limit set by LimitFirstN.
+func (d *Durafmt) WithPrecision(p Precision) *Durafmt {
+	c := d.Clone()
+	c.limitN, c.round = 0, false
+	switch p {
+	case Normal:
+		c.limitN = 2
+	case Coarse:
+		c.limitN, c.round = 1, true
+	}
+	return c
+}
@@ -0,0 +1,34 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWithPrecision(t *testing.T) {
+	testPrecision := []struct {
+		test      time.Duration
+		precision Precision
+		expected  string
+	}{
+		{2*time.Hour + 40*time.Minute + 10*time.Second, Fine, "2 ч. 40 мин. 10 сек."},
+		{2*time.Hour + 40*time.Minute + 10*time.Second, Normal, "2 ч. 40 мин."},
+		{2*time.Hour + 40*time.Minute + 10*time.Second, Coarse, "3 ч."},
+		{2*time.Hour + 20*time.Minute, Coarse, "2 ч."},
+		{-(2*time.Hour + 30*time.Minute), Coarse, "-3 ч."},
+		{6*24*time.Hour + 23*time.Hour, Coarse, "1 нед."},
+		{45 * time.Second, Coarse, "45 сек."},
+	}
+
+	for _, table := range testPrecision {
+		result := Parse(table.test).WithPrecision(table.precision).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithPrecision(%d).String() got %q, expected %q", table.test, table.precision, result, table.expected)
+		}
+	}
+
+	result := Parse(2*time.Hour + 40*time.Minute).WithPrecision(Coarse).WithPrecision(Fine).String()
+	if expected := "2 ч. 40 мин."; result != expected {
+		t.Errorf("WithPrecision(Fine).String() got %q, expected %q", result, expected)
+	}
+}
@@ -0,0 +1,102 @@
+package durafmt
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// promqlUnits are the units of PromQL durations from the biggest, years of 365 days.
+var promqlUnits = []struct {
+	suffix string
+	size   time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+}
+
+// ParsePromQL creates a new *Durafmt struct from a Prometheus or Grafana duration, integers
+// followed by the units y, w, d, h, m, s or ms from the biggest, e.g. "30s", "7d" or "1h30m".
+// "0" and a leading minus sign, as in offsets, are accepted.
+func ParsePromQL(input string) (*Durafmt, error) {
+	s := input
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+	fail := func() (*Durafmt, error) {
+		return nil, errors.New("durafmt: invalid PromQL duration " + strconv.Quote(input))
+	}
+	if s == "" {
+		return fail()
+	}
+
+	if s == "0" {
+		s = ""
+	}
+
+	var duration time.Duration
+	next := 0
+	for s != "" {
+		digits := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if digits <= 0 {
+			return fail()
+		}
+		n, err := strconv.ParseInt(s[:digits], 10, 64)
+		if err != nil {
+			return fail()
+		}
+		s = s[digits:]
+
+		i := next
+		for ; i < len(promqlUnits); i++ {
+			suffix := promqlUnits[i].suffix
+			// "m" must not take the "m" of "ms".
+			if strings.HasPrefix(s, suffix) && !(suffix == "m" && strings.HasPrefix(s, "ms")) {
+				break
+			}
+		}
+		if i == len(promqlUnits) {
+			return fail()
+		}
+		size := promqlUnits[i].size
+		if n > (math.MaxInt64-int64(duration))/int64(size) {
+			return fail()
+		}
+		duration += time.Duration(n) * size
+		s = s[len(promqlUnits[i].suffix):]
+		next = i + 1
+	}
+	if negative {
+		duration = -duration
+	}
+	return Parse(duration), nil
+}
+
+// PromQL formats the duration as a Prometheus or Grafana duration, e.g. "1h30m" or "7d",
+// truncated to the millisecond, the resolution of PromQL. A zero duration is "0s".
+func (d *Durafmt) PromQL() string {
+	duration := d.Duration().Truncate(time.Millisecond)
+	if duration == 0 {
+		return "0s"
+	}
+	var b strings.Builder
+	if duration < 0 {
+		b.WriteByte('-')
+	}
+	positive := abs(duration)
+	for _, u := range promqlUnits {
+		if n := positive / u.size; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10) + u.suffix)
+			positive -= n * u.size
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePromQL(t *testing.T) {
+	day := 24 * time.Hour
+
+	testParsePromQL := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{"30s", 30 * time.Second, false},
+		{"5m", 5 * time.Minute, false},
+		{"1h", time.Hour, false},
+		{"7d", 7 * day, false},
+		{"2w", 14 * day, false},
+		{"1y", 365 * day, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"1m500ms", time.Minute + 500*time.Millisecond, false},
+		{"250ms", 250 * time.Millisecond, false},
+		{"-5m", -5 * time.Minute, false},
+		{"0", 0, false},
+		{"0s", 0, false},
+		{"30m1h", 0, true},
+		{"1h1h", 0, true},
+		{"1.5h", 0, true},
+		{"5", 0, true},
+		{"1h0", 0, true},
+		{"1us", 0, true},
+		{"h", 0, true},
+		{"", 0, true},
+		{"-", 0, true},
+		{"300y", 0, true},
+	}
+
+	for _, table := range testParsePromQL {
+		d, err := ParsePromQL(table.input)
+		if (err != nil) != table.err {
+			t.Errorf("ParsePromQL(%q) got error %v, expected error %v", table.input, err, table.err)
+			continue
+		}
+		if err == nil && d.Duration() != table.expected {
+			t.Errorf("ParsePromQL(%q) got %v, expected %v", table.input, d.Duration(), table.expected)
+		}
+	}
+}
+
+func TestPromQL(t *testing.T) {
+	testPromQL := []struct {
+		test     time.Duration
+		expected string
+	}{
+		{30 * time.Second, "30s"},
+		{7 * 24 * time.Hour, "1w"},
+		{9 * 24 * time.Hour, "1w2d"},
+		{366 * 24 * time.Hour, "1y1d"},
+		{90*time.Minute + 1500*time.Microsecond, "1h30m1ms"},
+		{-5 * time.Minute, "-5m"},
+		{999 * time.Microsecond, "0s"},
+		{0, "0s"},
+	}
+
+	for _, table := range testPromQL {
+		if result := Parse(table.test).PromQL(); result != table.expected {
+			t.Errorf("PromQL(%s) got %q, expected %q", table.test, result, table.expected)
+		}
+		if d, err := ParsePromQL(table.expected); err != nil || d.Duration() != table.test.Truncate(time.Millisecond) {
+			t.Errorf("ParsePromQL(%q) got %v %v, expected %v", table.expected, d.Duration(), err, table.test.Truncate(time.Millisecond))
+		}
+	}
+}
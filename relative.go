@@ -0,0 +1,132 @@
+package durafmt
+
+import (
+	"fmt"
+	"time"
+)
+
+// now returns the current time, replaced in tests.
+var now = time.Now
+
+// Since returns the time elapsed since t, the shorthand of Parse(time.Since(t)).Apply(opts...).
+// The current time is taken from WithNow among opts. The subtraction uses the monotonic
+// clock reading when t has one.
+func Since(t time.Time, opts ...Option) *Durafmt {
+	return Parse(clockOf(opts).Sub(t)).Apply(opts...)
+}
+
+// UntilTime returns the time left until t, the shorthand of Parse(time.Until(t)).Apply(opts...).
+// The current time is taken from WithNow among opts. The subtraction uses the monotonic
+// clock reading when t has one.
+func UntilTime(t time.Time, opts ...Option) *Durafmt {
+	return Parse(t.Sub(clockOf(opts))).Apply(opts...)
+}
+
+// Between returns the time from a to b, negative if b is before a.
+// Whole days are counted on the wall clock of a's location, so a day across
+// a DST transition is "1 дн." although it lasts 23 or 25 hours, and years on the calendar,
+// so a year spanning February 29 is "1 год", not "1 год 1 дн.".
+// Use BetweenAbsolute to count the elapsed time instead.
+func Between(a, b time.Time) *Durafmt {
+	negative := b.Before(a)
+	if negative {
+		a, b = b.In(a.Location()), a
+	}
+
+	duration := calendarDuration(a, b)
+	if negative {
+		duration = -duration
+	}
+	d := Parse(duration)
+
+	// count calendar years, a year spanning February 29 lasts 366 days.
+	years := b.Year() - a.Year()
+	if a.AddDate(years, 0, 0).After(b) {
+		years--
+	}
+	d.calendar = true
+	d.years = int64(years)
+	d.yearsSpan = calendarDuration(a, a.AddDate(years, 0, 0))
+	return d
+}
+
+// BetweenAbsolute returns the time elapsed from a to b, negative if b is before a.
+func BetweenAbsolute(a, b time.Time) *Durafmt {
+	return Parse(b.Sub(a))
+}
+
+// calendarDuration returns the time from a to b, a not after b, as 24 hours for
+// every whole day on the wall clock of a's location and the elapsed rest.
+func calendarDuration(a, b time.Time) time.Duration {
+	days := int(b.Sub(a) / (24 * time.Hour))
+	for days > 0 && a.AddDate(0, 0, days).After(b) {
+		days--
+	}
+	for !a.AddDate(0, 0, days+1).After(b) {
+		days++
+	}
+	return add(mul(24*time.Hour, int64(days)), b.Sub(a.AddDate(0, 0, days)))
+}
+
+// BetweenUnix returns the time from a to b, Unix timestamps in seconds,
+// e.g. the gap between two events of a log. It is negative if b is before a.
+func BetweenUnix(a, b int64) *Durafmt {
+	return BetweenAbsolute(time.Unix(a, 0), time.Unix(b, 0))
+}
+
+// BetweenUnixMilli returns the time from a to b, Unix timestamps in milliseconds
+// such as the timestamps of Kafka records. It is negative if b is before a.
+func BetweenUnixMilli(a, b int64) *Durafmt {
+	return BetweenAbsolute(time.Unix(a/1000, a%1000*int64(time.Millisecond)), time.Unix(b/1000, b%1000*int64(time.Millisecond)))
+}
+
+// UntilNext returns the time until the next midnight starting the day of the month,
+// e.g. a birthday or a renewal date. On the day itself the next year is counted.
+// February 29 falls on March 1 in common years.
+func UntilNext(month time.Month, day int) *Durafmt {
+	t := now()
+	next := time.Date(t.Year(), month, day, 0, 0, 0, 0, t.Location())
+	if !next.After(t) {
+		next = time.Date(t.Year()+1, month, day, 0, 0, 0, 0, t.Location())
+	}
+	return Between(t, next)
+}
+
+// UntilAnniversary returns the time until the next anniversary of the date of t, see UntilNext.
+func UntilAnniversary(t time.Time) *Durafmt {
+	return UntilNext(t.Month(), t.Day())
+}
+
+// WithNow sets the clock of relative phrases such as Remaining, so tests and replay
+// tooling can pin the current time. nil means time.Now.
+func (d *Durafmt) WithNow(now func() time.Time) *Durafmt {
+	c := d.Clone()
+	c.now = now
+	return c
+}
+
+// clock returns the current time of relative phrases.
+func (d *Durafmt) clock() time.Time {
+	if d.now != nil {
+		return d.now()
+	}
+	return now()
+}
+
+// clockOf returns the current time of the clock set by opts, see WithNow.
+func clockOf(opts []Option) time.Time {
+	return Parse(0).Apply(opts...).clock()
+}
+
+// Remaining returns a phrase about the time left until deadline, e.g. "осталось 3 дн. 4 ч.",
+// switching to "просрочено на 2 ч." once the deadline is passed.
+// The two biggest units are shown unless the options say otherwise.
+func Remaining(deadline time.Time, opts ...Option) string {
+	d := Parse(0).LimitFirstN(2).Apply(opts...)
+	d = d.withDuration(deadline.Sub(d.clock()))
+	locale := d.Locale()
+	if d.duration < 0 {
+		return fmt.Sprintf(locale.Overdue, d.withDuration(abs(d.duration)))
+	}
+	return fmt.Sprintf(locale.Remaining, d)
+}
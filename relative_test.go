@@ -0,0 +1,167 @@
+package durafmt
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRemaining(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() { now = time.Now }()
+
+	english := InLocale(English)
+
+	testRemaining := []struct {
+		test     string
+		expected string
+	}{
+		{Remaining(start.Add(3*24*time.Hour + 4*time.Hour + 5*time.Minute)), "осталось 3 дн. 4 ч."},
+		{Remaining(start.Add(-2 * time.Hour)), "просрочено на 2 ч."},
+		{Remaining(start.Add(2*time.Hour), english), "2 hours left"},
+		{Remaining(start.Add(-2*time.Hour-30*time.Minute), english), "overdue by 2 hours 30 minutes"},
+	}
+
+	for _, table := range testRemaining {
+		if table.test != table.expected {
+			t.Errorf("Remaining() got %q, expected %q", table.test, table.expected)
+		}
+	}
+}
+
+func TestWithNow(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	pinned := func(d *Durafmt) *Durafmt {
+		return d.WithNow(func() time.Time { return start })
+	}
+
+	result := Remaining(start.Add(90*time.Minute), pinned)
+	expected := "осталось 1 ч. 30 мин."
+	if result != expected {
+		t.Errorf("Remaining() with WithNow got %q, expected %q", result, expected)
+	}
+}
+
+func TestSinceUntilTime(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() { now = time.Now }()
+	later := func(d *Durafmt) *Durafmt {
+		return d.WithNow(func() time.Time { return start.Add(time.Hour) })
+	}
+
+	testSinceUntil := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Since(start.Add(-2 * time.Hour)), "2 ч."},
+		{Since(start, later), "1 ч."},
+		{UntilTime(start.Add(3 * time.Minute)), "3 мин."},
+		{UntilTime(start.Add(-time.Second)), "-1 сек."},
+		{UntilTime(start.Add(3*time.Hour), later), "2 ч."},
+	}
+
+	for _, table := range testSinceUntil {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("Since/UntilTime got %q, expected %q", result, table.expected)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testBetween := []struct {
+		test     *Durafmt
+		expected time.Duration
+	}{
+		{Between(start, start.Add(90*time.Minute)), 90 * time.Minute},
+		{BetweenUnix(1577880000, 1577883600), time.Hour},
+		{BetweenUnix(1577883600, 1577880000), -time.Hour},
+		{BetweenUnix(-1e11, 1e11), math.MaxInt64},
+		{BetweenUnixMilli(1577880000000, 1577880001500), 1500 * time.Millisecond},
+		{BetweenUnixMilli(-1500, 500), 2 * time.Second},
+	}
+
+	for _, table := range testBetween {
+		if result := table.test.Duration(); result != table.expected {
+			t.Errorf("Duration() got %v, expected %v", result, table.expected)
+		}
+	}
+}
+
+func TestBetweenDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	// clocks go forward on 2020-03-29 and back on 2020-10-25.
+	spring := time.Date(2020, 3, 28, 12, 0, 0, 0, berlin)
+	autumn := time.Date(2020, 10, 24, 12, 0, 0, 0, berlin)
+
+	testBetweenDST := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Between(spring, spring.AddDate(0, 0, 1)), "1 дн."},
+		{BetweenAbsolute(spring, spring.AddDate(0, 0, 1)), "23 ч."},
+		{Between(autumn, autumn.AddDate(0, 0, 1)), "1 дн."},
+		{BetweenAbsolute(autumn, autumn.AddDate(0, 0, 1)), "1 дн. 1 ч."},
+		{Between(spring.AddDate(0, 0, 2).Add(30*time.Minute), spring), "-2 дн. 30 мин."},
+		{Between(spring, spring.Add(22*time.Hour)), "22 ч."},
+	}
+
+	for _, table := range testBetweenDST {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("Between() got %q, expected %q", result, table.expected)
+		}
+	}
+}
+
+func TestBetweenLeapYears(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	testBetweenLeapYears := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Between(date(2020, 1, 1), date(2021, 1, 1)), "1 год"},
+		{BetweenAbsolute(date(2020, 1, 1), date(2021, 1, 1)), "1 год 1 дн."},
+		{Between(date(2019, 6, 1), date(2023, 6, 15)), "4 года 2 нед."},
+		{Between(date(2021, 1, 1), date(2020, 1, 1)), "-1 год"},
+		{Between(date(2020, 1, 1), date(2020, 12, 31)), "52 нед. 1 дн."},
+		{Between(date(2020, 1, 1), date(2021, 1, 1)).LimitTo(Days), "366 дн."},
+		{Between(date(2020, 1, 1), date(2021, 1, 1)).Add(time.Hour), "1 год 1 дн. 1 ч."},
+	}
+
+	for _, table := range testBetweenLeapYears {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("Between() got %q, expected %q", result, table.expected)
+		}
+	}
+}
+
+func TestUntilNext(t *testing.T) {
+	start := time.Date(2020, 3, 10, 18, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() { now = time.Now }()
+
+	testUntilNext := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{UntilNext(time.March, 12), "1 дн. 6 ч."},
+		{UntilNext(time.March, 10), "52 нед. 6 ч."},
+		{UntilNext(time.January, 1).LimitFirstN(2), "42 нед. 2 дн."},
+		{UntilAnniversary(time.Date(1990, 4, 1, 0, 0, 0, 0, time.UTC)), "3 нед. 6 ч."},
+	}
+
+	for _, table := range testUntilNext {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("UntilNext() got %q, expected %q", result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package durafmt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// bundleFile is a bundle registered from a file, reloaded by ReloadLocales.
+// modTime is the modification time of the last load, or of the last failed reload
+// attempt seen by WatchLocales, zero if the file was missing.
+type bundleFile struct {
+	path    string
+	modTime time.Time
+}
+
+// bundleFiles are the registered bundle files, guarded by bundlesMu.
+var bundleFiles []bundleFile
+
+// RegisterBundleFile registers the JSON bundle read from the file at path like RegisterBundle,
+// and remembers the file so ReloadLocales and WatchLocales reload it.
+func RegisterBundleFile(path string) error {
+	b, modTime, err := readBundleFile(path)
+	if err != nil {
+		return err
+	}
+	if err := RegisterBundle(b); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	bundlesMu.Lock()
+	defer bundlesMu.Unlock()
+	for i := range bundleFiles {
+		if bundleFiles[i].path == path {
+			bundleFiles[i].modTime = modTime
+			return nil
+		}
+	}
+	bundleFiles = append(bundleFiles, bundleFile{path: path, modTime: modTime})
+	return nil
+}
+
+// ReloadLocales reads the files registered with RegisterBundleFile again, so translation fixes
+// reach long-running services without restarts. The locales are replaced only if every file
+// is valid, otherwise the error is returned and the loaded locales are kept.
+// Durafmt values keep the locale they were given, lookups after the reload get the new ones.
+func ReloadLocales() error {
+	bundlesMu.RLock()
+	files := append([]bundleFile(nil), bundleFiles...)
+	bundlesMu.RUnlock()
+
+	staged := make(map[string]Locale, len(files))
+	lookup := func(name string) (Locale, bool) {
+		for tag := name; tag != ""; tag = parentTag(tag) {
+			if l, ok := staged[strings.ToLower(tag)]; ok {
+				return l, true
+			}
+			if l, ok := lookupLocale(tag); ok {
+				return l, true
+			}
+		}
+		return Locale{}, false
+	}
+	for i, f := range files {
+		b, modTime, err := readBundleFile(f.path)
+		if err != nil {
+			return err
+		}
+		l, err := b.locale(lookup)
+		if err != nil {
+			return fmt.Errorf("%s: %v", f.path, err)
+		}
+		staged[strings.ToLower(b.Name)] = l
+		files[i].modTime = modTime
+	}
+
+	bundlesMu.Lock()
+	for name, l := range staged {
+		bundleLocales[name] = l
+	}
+	bundlesMu.Unlock()
+	recordBundleFiles(files)
+	return nil
+}
+
+// WatchLocales checks the files registered with RegisterBundleFile every interval and reloads
+// the locales with ReloadLocales when one of them changed, until ctx is done. The returned channel
+// receives the reload errors and is closed once ctx is done, errors a slow receiver is not ready for are dropped.
+func WatchLocales(ctx context.Context, interval time.Duration) <-chan error {
+	ch := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				files, changed := statBundleFiles()
+				if !changed {
+					continue
+				}
+				if err := ReloadLocales(); err != nil {
+					// report the error once, not again until a file changes.
+					recordBundleFiles(files)
+					select {
+					case ch <- err:
+					default:
+					}
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// statBundleFiles returns the registered bundle files with their current modification times,
+// zero for a missing file, and reports whether one of them changed since it was recorded.
+func statBundleFiles() ([]bundleFile, bool) {
+	bundlesMu.RLock()
+	defer bundlesMu.RUnlock()
+	files := make([]bundleFile, len(bundleFiles))
+	changed := false
+	for i, f := range bundleFiles {
+		files[i].path = f.path
+		if info, err := os.Stat(f.path); err == nil {
+			files[i].modTime = info.ModTime()
+		}
+		if !files[i].modTime.Equal(f.modTime) {
+			changed = true
+		}
+	}
+	return files, changed
+}
+
+// recordBundleFiles records the modification times of files for the registered bundle files.
+func recordBundleFiles(files []bundleFile) {
+	bundlesMu.Lock()
+	defer bundlesMu.Unlock()
+	for _, f := range files {
+		for i := range bundleFiles {
+			if bundleFiles[i].path == f.path {
+				bundleFiles[i].modTime = f.modTime
+			}
+		}
+	}
+}
+
+// readBundleFile reads the JSON bundle of the file at path and its modification time.
+func readBundleFile(path string) (Bundle, time.Time, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Bundle{}, time.Time{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return Bundle{}, time.Time{}, err
+	}
+	b, err := ReadBundle(f)
+	if err != nil {
+		return Bundle{}, time.Time{}, fmt.Errorf("%s: %v", path, err)
+	}
+	return b, info.ModTime(), nil
+}
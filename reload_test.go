@@ -0,0 +1,149 @@
+package durafmt
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// resetBundles forgets the registered bundles and bundle files.
+func resetBundles() {
+	bundlesMu.Lock()
+	defer bundlesMu.Unlock()
+	bundleLocales = make(map[string]Locale)
+	bundleFiles = nil
+}
+
+func TestReloadLocales(t *testing.T) {
+	defer resetBundles()
+	dir, err := ioutil.TempDir("", "durafmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en-x-reload.json")
+	write := func(hours string) {
+		bundle := `{"name": "en-x-reload", "words": {"hours": {"other": "` + hours + `"}}}`
+		if err := ioutil.WriteFile(path, []byte(bundle), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	format := func() string {
+		l, _ := LookupLocale("en-x-reload")
+		return Parse(2 * time.Hour).WithLocale(l).Accessible().String()
+	}
+
+	write("hrs")
+	if err := RegisterBundleFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if result, expected := format(), "2 hrs"; result != expected {
+		t.Errorf("RegisterBundleFile() got %q, expected %q", result, expected)
+	}
+
+	write("hours of work")
+	if err := ReloadLocales(); err != nil {
+		t.Fatal(err)
+	}
+	if result, expected := format(), "2 hours of work"; result != expected {
+		t.Errorf("ReloadLocales() got %q, expected %q", result, expected)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(`{"name": "en-x-reload", "words": {"hours": {"twelve": "h"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ReloadLocales(); err == nil {
+		t.Errorf("ReloadLocales() expected an error for an invalid bundle")
+	}
+	if result, expected := format(), "2 hours of work"; result != expected {
+		t.Errorf("ReloadLocales() of an invalid bundle got %q, expected %q", result, expected)
+	}
+}
+
+func TestWatchLocales(t *testing.T) {
+	defer resetBundles()
+	dir, err := ioutil.TempDir("", "durafmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en-x-watch.json")
+	if err := ioutil.WriteFile(path, []byte(`{"name": "en-x-watch", "units": ["y", "w", "d", "h", "m", "s", "ms", "µs", "ns"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterBundleFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := WatchLocales(ctx, time.Millisecond)
+
+	if err := ioutil.WriteFile(path, []byte(`{"name": "en-x-watch", "units": ["y", "w", "d", "hr", "m", "s", "ms", "µs", "ns"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "2 hr"
+	result := ""
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		l, _ := LookupLocale("en-x-watch")
+		if result = Parse(2 * time.Hour).WithLocale(l).String(); result == expected {
+			break
+		}
+	}
+	if result != expected {
+		t.Errorf("WatchLocales() got %q, expected %q", result, expected)
+	}
+
+	cancel()
+	for err := range errs {
+		t.Errorf("WatchLocales() got error %v", err)
+	}
+}
+
+func TestWatchLocalesMissingFile(t *testing.T) {
+	defer resetBundles()
+	dir, err := ioutil.TempDir("", "durafmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en-x-missing.json")
+	if err := ioutil.WriteFile(path, []byte(`{"name": "en-x-missing"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterBundleFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := WatchLocales(ctx, time.Millisecond)
+
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchLocales() expected an error for a missing file")
+	}
+	select {
+	case err := <-errs:
+		t.Errorf("WatchLocales() got a repeated error %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for err := range errs {
+		t.Errorf("WatchLocales() got error %v after cancel", err)
+	}
+}
@@ -0,0 +1,95 @@
+package durafmt
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// RoundingMode tells RoundTo how to round a duration to a whole number of a unit.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest whole unit, halves away from zero: 1h29m is "1 ч.".
+	RoundHalfUp RoundingMode = iota
+	// RoundDown rounds towards zero: 1h59m is "1 ч.".
+	RoundDown
+	// RoundUp rounds away from zero: 1h1m is "2 ч.".
+	RoundUp
+	// RoundTenths rounds to the nearest tenth of the unit, shown as a decimal: 1h29m is "1,5 ч.".
+	RoundTenths
+)
+
+// Duration returns the length of the unit u.
+func (u Unit) Duration() time.Duration {
+	return time.Duration(unitSizes[u])
+}
+
+// RoundTo returns a new Durafmt holding the duration rounded to a whole number of the unit u,
+// with the same output format as d.
+func (d *Durafmt) RoundTo(u Unit, mode RoundingMode) *Durafmt {
+	size := u.Duration()
+	switch mode {
+	case RoundDown:
+		return d.withDuration(d.Duration().Truncate(size))
+	case RoundUp:
+		return d.withDuration(roundUp(d.Duration(), size))
+	case RoundTenths:
+		c := d.withDuration(d.Duration().Round(size / 10))
+		c.decimalPlaces = 1
+		c.decimalUnit = u
+		return c
+	default:
+		return d.withDuration(d.Duration().Round(size))
+	}
+}
+
+// RoundToIncrement returns a new Durafmt holding the duration rounded to a multiple of inc,
+// with the same output format as d, e.g. RoundToIncrement(6*time.Minute, RoundUp) for billing
+// in tenths of an hour. RoundTenths rounds like RoundHalfUp. A non-positive inc keeps the duration.
+func (d *Durafmt) RoundToIncrement(inc time.Duration, mode RoundingMode) *Durafmt {
+	if inc <= 0 {
+		return d.withDuration(d.Duration())
+	}
+	switch mode {
+	case RoundDown:
+		return d.withDuration(d.Duration().Truncate(inc))
+	case RoundUp:
+		return d.withDuration(roundUp(d.Duration(), inc))
+	default:
+		return d.withDuration(d.Duration().Round(inc))
+	}
+}
+
+// roundUp rounds duration away from zero to a multiple of size.
+func roundUp(duration, size time.Duration) time.Duration {
+	truncated := duration.Truncate(size)
+	switch {
+	case truncated == duration:
+		return duration
+	case duration > 0:
+		return add(truncated, size)
+	default:
+		return sub(truncated, size)
+	}
+}
+
+// decimalComponent returns the duration as a decimal number of decimalUnit, e.g. "1,5 ч.".
+func (d *Durafmt) decimalComponent() component {
+	duration := abs(d.Duration())
+	size := d.decimalUnit.Duration()
+	scale := math.Pow10(d.decimalPlaces)
+
+	value := int64(duration / size)
+	fraction := math.Round(float64(duration%size) / float64(size) * scale)
+	if fraction >= scale {
+		value++
+		fraction = 0
+	}
+
+	digits := strconv.FormatInt(int64(fraction), 10)
+	for len(digits) < d.decimalPlaces {
+		digits = "0" + digits
+	}
+	return component{unit: d.decimalUnit, value: value, fraction: digits}
+}
@@ -0,0 +1,84 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundTo(t *testing.T) {
+	testTimesRoundTo := []struct {
+		test     time.Duration
+		unit     Unit
+		mode     RoundingMode
+		locale   Locale
+		expected string
+	}{
+		{time.Hour + 29*time.Minute, Hours, RoundHalfUp, Russian, "1 ч."},
+		{time.Hour + 30*time.Minute, Hours, RoundHalfUp, Russian, "2 ч."},
+		{time.Hour + 59*time.Minute, Hours, RoundDown, Russian, "1 ч."},
+		{time.Hour + time.Minute, Hours, RoundUp, Russian, "2 ч."},
+		{-(time.Hour + time.Minute), Hours, RoundUp, Russian, "-2 ч."},
+		{2 * time.Hour, Hours, RoundUp, Russian, "2 ч."},
+		{time.Hour + 29*time.Minute, Hours, RoundTenths, Russian, "1,5 ч."},
+		{time.Hour + 29*time.Minute, Hours, RoundTenths, English, "1.5 hours"},
+		{time.Hour + 2*time.Minute, Hours, RoundTenths, English, "1.0 hours"},
+		{-90 * time.Second, Minutes, RoundTenths, Russian, "-1,5 мин."},
+		{25*time.Hour + 40*time.Minute, Hours, RoundHalfUp, Russian, "1 дн. 2 ч."},
+	}
+
+	for _, table := range testTimesRoundTo {
+		result := Parse(table.test).WithLocale(table.locale).RoundTo(table.unit, table.mode).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).RoundTo(%s, %d).String() got %q, expected %q",
+				table.test, table.unit, table.mode, result, table.expected)
+		}
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	testTimesRoundToIncrement := []struct {
+		test     time.Duration
+		inc      time.Duration
+		mode     RoundingMode
+		expected string
+	}{
+		{61 * time.Minute, 6 * time.Minute, RoundUp, "1 ч. 6 мин."},
+		{60 * time.Minute, 6 * time.Minute, RoundUp, "1 ч."},
+		{68 * time.Minute, 15 * time.Minute, RoundHalfUp, "1 ч. 15 мин."},
+		{67 * time.Minute, 15 * time.Minute, RoundDown, "1 ч."},
+		{-7 * time.Minute, 15 * time.Minute, RoundUp, "-15 мин."},
+		{8 * time.Minute, 15 * time.Minute, RoundTenths, "15 мин."},
+		{7 * time.Minute, 0, RoundUp, "7 мин."},
+	}
+
+	for _, table := range testTimesRoundToIncrement {
+		result := Parse(table.test).RoundToIncrement(table.inc, table.mode).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).RoundToIncrement(%s, %d).String() got %q, expected %q",
+				table.test, table.inc, table.mode, result, table.expected)
+		}
+	}
+}
+
+func TestCeilFloor(t *testing.T) {
+	testTimesCeil := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(300 * time.Millisecond).DropBelow(Seconds).Ceil(), "1 сек."},
+		{Parse(300 * time.Millisecond).DropBelow(Seconds).Floor(), "0 сек."},
+		{Parse(300 * time.Millisecond).DropBelow(Seconds).Ceil().Floor(), "0 сек."},
+		{Parse(time.Hour + 59*time.Minute).LimitFirstN(1).Ceil(), "2 ч."},
+		{Parse(time.Hour + 59*time.Minute).LimitFirstN(1).Floor(), "1 ч."},
+		{Parse(59*time.Minute + 30*time.Second).LimitFirstN(1).Ceil(), "1 ч."},
+		{Parse(-(time.Hour + time.Second)).LimitFirstN(1).Ceil(), "-2 ч."},
+		{Parse(2 * time.Hour).LimitFirstN(1).Ceil(), "2 ч."},
+		{Parse(500 * time.Nanosecond).Ceil(), "1 мкс."},
+	}
+
+	for _, table := range testTimesCeil {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+}
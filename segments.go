@@ -0,0 +1,37 @@
+package durafmt
+
+import (
+	"fmt"
+	"time"
+)
+
+// Segments returns how many whole segments fit into total and the time left over,
+// e.g. 4 pomodoros of 25 minutes and 10 minutes in 110 minutes.
+// A segment not longer than zero fits no times.
+func Segments(total, segment time.Duration) (int64, *Durafmt) {
+	if segment <= 0 {
+		return 0, Parse(total)
+	}
+	return int64(total / segment), Parse(total % segment)
+}
+
+// FormatSegments describes the segments fitting into total and the time left over,
+// e.g. "4 интервала по 25 мин. и ещё 10 мин.". The options format both durations.
+// A negative total is counted by its absolute value, and a segment not longer than zero
+// gives the total alone, e.g. "1 ч. 50 мин.".
+func FormatSegments(total, segment time.Duration, opts ...Option) string {
+	total = abs(total)
+	if segment <= 0 {
+		return Parse(total).Apply(opts...).String()
+	}
+	n, rest := Segments(total, segment)
+	rest = rest.Apply(opts...)
+	length := rest.withDuration(segment)
+
+	locale := rest.Locale()
+	s := fmt.Sprintf(locale.Segments, rest.formatNumber(n), locale.Interval.Form(locale.plural(n)), length)
+	if rest.duration != 0 {
+		s += fmt.Sprintf(locale.SegmentsRest, rest)
+	}
+	return s
+}
@@ -0,0 +1,33 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatSegments(t *testing.T) {
+	english := InLocale(English)
+
+	testFormatSegments := []struct {
+		test     string
+		expected string
+	}{
+		{FormatSegments(110*time.Minute, 25*time.Minute), "4 интервала по 25 мин. и ещё 10 мин."},
+		{FormatSegments(2*time.Hour, 30*time.Minute), "4 интервала по 30 мин."},
+		{FormatSegments(5*time.Hour+30*time.Minute, time.Hour), "5 интервалов по 1 ч. и ещё 30 мин."},
+		{FormatSegments(90*time.Minute, time.Hour, english), "1 interval of 1 hour and 30 minutes more"},
+		{FormatSegments(110*time.Minute, 0), "1 ч. 50 мин."},
+		{FormatSegments(110*time.Minute, -time.Minute), "1 ч. 50 мин."},
+		{FormatSegments(-110*time.Minute, 25*time.Minute), "4 интервала по 25 мин. и ещё 10 мин."},
+	}
+
+	for _, table := range testFormatSegments {
+		if table.test != table.expected {
+			t.Errorf("FormatSegments() got %q, expected %q", table.test, table.expected)
+		}
+	}
+
+	if n, rest := Segments(time.Hour, 0); n != 0 || rest.Duration() != time.Hour {
+		t.Errorf("Segments(1h, 0) got %d, %v, expected 0, 1h0m0s", n, rest.Duration())
+	}
+}
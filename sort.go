@@ -0,0 +1,29 @@
+package durafmt
+
+import (
+	"sort"
+	"time"
+)
+
+// Durations attaches the methods of sort.Interface to []time.Duration, sorting in increasing order.
+// Use sort.Reverse for top-N reports.
+type Durations []time.Duration
+
+func (p Durations) Len() int           { return len(p) }
+func (p Durations) Less(i, j int) bool { return p[i] < p[j] }
+func (p Durations) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// Strings returns the human readable durations of p, in the order of p.
+func (p Durations) Strings() []string {
+	list := make([]string, len(p))
+	for i, d := range p {
+		list[i] = Parse(d).String()
+	}
+	return list
+}
+
+// SortStrings sorts ds in increasing order and returns their human readable durations in that order.
+func SortStrings(ds []time.Duration) []string {
+	sort.Sort(Durations(ds))
+	return Durations(ds).Strings()
+}
@@ -0,0 +1,25 @@
+package durafmt
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSortStrings(t *testing.T) {
+	ds := []time.Duration{time.Hour, time.Second, -time.Minute, 90 * time.Minute}
+
+	result := SortStrings(ds)
+	expected := []string{"-1 мин.", "1 сек.", "1 ч.", "1 ч. 30 мин."}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("SortStrings() got %q, expected %q", result, expected)
+	}
+
+	sort.Sort(sort.Reverse(Durations(ds)))
+	result = Durations(ds).Strings()
+	expected = []string{"1 ч. 30 мин.", "1 ч.", "1 сек.", "-1 мин."}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("sort.Reverse(Durations).Strings() got %q, expected %q", result, expected)
+	}
+}
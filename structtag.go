@@ -0,0 +1,64 @@
+package durafmt
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// HumanizeStruct returns the human readable form of the exported time.Duration fields
+// of the struct v, or of the struct v points to, keyed by field name, so report structs
+// render declaratively. The field tags set the output format, e.g.
+//
+//	Elapsed time.Duration `durafmt:"limit=2,locale=en"`
+//
+// with the keys "style" (see WithStyle), "limit" (see LimitFirstN) and "locale" (see LookupLocale).
+// Fields tagged `durafmt:"-"` are skipped, unknown keys and invalid values are ignored.
+func HumanizeStruct(v interface{}) map[string]string {
+	fields := make(map[string]string)
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return fields
+	}
+
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("durafmt")
+		if field.PkgPath != "" || field.Type != durationType || tag == "-" {
+			continue
+		}
+		d := Parse(time.Duration(value.Field(i).Int()))
+		fields[field.Name] = applyTag(d, tag).String()
+	}
+	return fields
+}
+
+// applyTag sets the output format of d from a durafmt struct tag.
+func applyTag(d *Durafmt, tag string) *Durafmt {
+	for _, option := range strings.Split(tag, ",") {
+		key, value := option, ""
+		if i := strings.IndexByte(option, '='); i >= 0 {
+			key, value = option[:i], option[i+1:]
+		}
+		switch strings.TrimSpace(key) {
+		case "style":
+			d = d.WithStyle(value)
+		case "limit":
+			if n, err := strconv.Atoi(value); err == nil {
+				d = d.LimitFirstN(n)
+			}
+		case "locale":
+			if l, ok := LookupLocale(value); ok {
+				d = d.WithLocale(l)
+			}
+		}
+	}
+	return d
+}
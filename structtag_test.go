@@ -0,0 +1,41 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHumanizeStruct(t *testing.T) {
+	type report struct {
+		Name     string
+		Elapsed  time.Duration
+		Wait     time.Duration `durafmt:"limit=1,locale=en"`
+		Timeout  time.Duration `durafmt:"locale=xx,limit=many"`
+		Internal time.Duration `durafmt:"-"`
+		hidden   time.Duration
+	}
+	r := report{
+		Name:     "build",
+		Elapsed:  90 * time.Second,
+		Wait:     2*time.Hour + 30*time.Minute,
+		Timeout:  time.Minute,
+		Internal: time.Second,
+		hidden:   time.Second,
+	}
+	expected := map[string]string{
+		"Elapsed": "1 мин. 30 сек.",
+		"Wait":    "2 hours",
+		"Timeout": "1 мин.",
+	}
+
+	if result := HumanizeStruct(r); !reflect.DeepEqual(result, expected) {
+		t.Errorf("HumanizeStruct() got %q, expected %q", result, expected)
+	}
+	if result := HumanizeStruct(&r); !reflect.DeepEqual(result, expected) {
+		t.Errorf("HumanizeStruct(&r) got %q, expected %q", result, expected)
+	}
+	if result := HumanizeStruct(42); len(result) != 0 {
+		t.Errorf("HumanizeStruct(42) got %q, expected an empty map", result)
+	}
+}
@@ -0,0 +1,75 @@
+package durafmt
+
+import (
+	"sort"
+	"sync"
+)
+
+// Style is a named output format, registered once with RegisterStyle
+// and selected by name with WithStyle across services.
+type Style interface {
+	// Format formats d, which has no style set.
+	Format(d *Durafmt) string
+}
+
+// StyleFunc adapts an ordinary function to a Style.
+type StyleFunc func(d *Durafmt) string
+
+// Format calls f(d).
+func (f StyleFunc) Format(d *Durafmt) string {
+	return f(d)
+}
+
+var (
+	stylesMu sync.RWMutex
+	styles   = make(map[string]Style)
+)
+
+// RegisterStyle makes a style available by the provided name.
+// If RegisterStyle is called twice with the same name or if style is nil, it panics.
+func RegisterStyle(name string, style Style) {
+	stylesMu.Lock()
+	defer stylesMu.Unlock()
+	if style == nil {
+		panic("durafmt: RegisterStyle style is nil")
+	}
+	if _, dup := styles[name]; dup {
+		panic("durafmt: RegisterStyle called twice for style " + name)
+	}
+	styles[name] = style
+}
+
+// LookupStyle returns the style registered by the provided name.
+func LookupStyle(name string) (Style, bool) {
+	stylesMu.RLock()
+	defer stylesMu.RUnlock()
+	style, ok := styles[name]
+	return style, ok
+}
+
+// Styles returns a sorted list of the names of the registered styles.
+func Styles() []string {
+	stylesMu.RLock()
+	defer stylesMu.RUnlock()
+	list := make([]string, 0, len(styles))
+	for name := range styles {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// WithStyle sets the output format to the style registered by the provided name.
+// Unknown names and "" restore the default format.
+func (d *Durafmt) WithStyle(name string) *Durafmt {
+	c := d.Clone()
+	c.style, _ = LookupStyle(name)
+	return c
+}
+
+// formatStyle formats d with its style, passing a copy without the style to avoid recursion.
+func (d *Durafmt) formatStyle() string {
+	plain := d.Clone()
+	plain.style = nil
+	return d.style.Format(plain)
+}
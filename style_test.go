@@ -0,0 +1,45 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	RegisterStyle("test-ops-short", StyleFunc(func(d *Durafmt) string {
+		return d.LimitFirstN(1).WithMaxLen(3).String()
+	}))
+	RegisterStyle("test-report-long", StyleFunc(func(d *Durafmt) string {
+		return d.WithLocale(English).String()
+	}))
+
+	testTimesWithStyle := []struct {
+		test     time.Duration
+		style    string
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, "", "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, "unknown", "2 ч. 30 мин."},
+		{2*time.Hour + 30*time.Minute, "test-ops-short", "2ч"},
+		{2*time.Hour + 30*time.Minute, "test-report-long", "2 hours 30 minutes"},
+	}
+
+	for _, table := range testTimesWithStyle {
+		result := Parse(table.test).WithStyle(table.style).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithStyle(%q).String() got %q, expected %q",
+				table.test, table.style, result, table.expected)
+		}
+	}
+
+	if _, ok := LookupStyle("test-ops-short"); !ok {
+		t.Errorf("LookupStyle(%q) not found", "test-ops-short")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterStyle() twice did not panic")
+		}
+	}()
+	RegisterStyle("test-ops-short", StyleFunc(func(d *Durafmt) string { return "" }))
+}
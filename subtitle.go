@@ -0,0 +1,35 @@
+package durafmt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ParseSRT creates a new *Durafmt struct from a SubRip timestamp, "00:01:23,456".
+func ParseSRT(input string) (*Durafmt, error) {
+	duration, ok := parseSexagesimal(strings.TrimSpace(input), ',')
+	if !ok || !strings.Contains(input, ":") {
+		return nil, errors.New("durafmt: invalid SRT timestamp " + strconv.Quote(input))
+	}
+	return Parse(duration), nil
+}
+
+// ParseVTT creates a new *Durafmt struct from a WebVTT timestamp, "00:01:23.456" or "01:23.456".
+func ParseVTT(input string) (*Durafmt, error) {
+	duration, ok := parseSexagesimal(strings.TrimSpace(input), '.')
+	if !ok || !strings.Contains(input, ":") {
+		return nil, errors.New("durafmt: invalid WebVTT timestamp " + strconv.Quote(input))
+	}
+	return Parse(duration), nil
+}
+
+// SRT formats the duration as a SubRip timestamp, e.g. "00:01:23,456".
+func (d *Durafmt) SRT() string {
+	return sexagesimal(d.Duration(), ',')
+}
+
+// VTT formats the duration as a WebVTT timestamp, e.g. "00:01:23.456".
+func (d *Durafmt) VTT() string {
+	return sexagesimal(d.Duration(), '.')
+}
@@ -0,0 +1,34 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubtitleTimestamps(t *testing.T) {
+	srt, err := ParseSRT("00:01:23,456")
+	if err != nil || srt.Duration() != 83456*time.Millisecond {
+		t.Errorf("ParseSRT() got %v, %v, expected %v", srt, err, 83456*time.Millisecond)
+	}
+	vtt, err := ParseVTT("01:23.456")
+	if err != nil || vtt.Duration() != 83456*time.Millisecond {
+		t.Errorf("ParseVTT() got %v, %v, expected %v", vtt, err, 83456*time.Millisecond)
+	}
+
+	for _, input := range []string{"00:01:23.456", "-00:00:01,000", "83,456"} {
+		if _, err := ParseSRT(input); err == nil {
+			t.Errorf("ParseSRT(%q) got no error", input)
+		}
+	}
+	if _, err := ParseVTT("83.456"); err == nil {
+		t.Errorf("ParseVTT(%q) got no error", "83.456")
+	}
+
+	shifted := srt.Add(1500 * time.Millisecond)
+	if result, expected := shifted.SRT(), "00:01:24,956"; result != expected {
+		t.Errorf("SRT() got %q, expected %q", result, expected)
+	}
+	if result, expected := shifted.VTT(), "00:01:24.956"; result != expected {
+		t.Errorf("VTT() got %q, expected %q", result, expected)
+	}
+}
@@ -0,0 +1,59 @@
+package durafmt
+
+// WithSutki sets the output format to count days in "сутки", as in "3 сут." or "3 суток простоя",
+// common in Russian operational contexts. Locales other than Russian are left untouched.
+func (d *Durafmt) WithSutki() *Durafmt {
+	locale := d.Locale()
+	if locale.Name != Russian.Name {
+		return d
+	}
+	return d.WithLocale(locale.withDays(sutkiAbbreviation, sutkiWords, sutkiDeclensions, PluraleTantum, "суток"))
+}
+
+const sutkiAbbreviation = "сут."
+
+var (
+	// sutkiWords holds the nominative forms of "сутки", a noun used in the plural only.
+	sutkiWords = UnitForms{PluralOne: "сутки", PluralFew: "суток", PluralMany: "суток", PluralOther: "суток"}
+
+	sutkiDeclensions = map[GrammaticalCase]UnitForms{
+		Genitive:     {PluralOther: "суток"},
+		Accusative:   {PluralOne: "сутки", PluralOther: "суток"},
+		Instrumental: {PluralOne: "сутками", PluralFew: "сутками", PluralMany: "сутками", PluralOther: "суток"},
+	}
+)
+
+// withDays returns a copy of l with another day unit. The tables of l are copied, not modified.
+func (l Locale) withDays(abbr string, words UnitForms, declensions map[GrammaticalCase]UnitForms, g Gender, single string) Locale {
+	l.Units = replaceString(l.Units, Days, abbr)
+	if int(Days) < len(l.UnitPlurals) {
+		l.UnitPlurals = append([]UnitForms(nil), l.UnitPlurals...)
+		l.UnitPlurals[Days] = nil
+	}
+	l.SingleUnits = replaceString(l.SingleUnits, Days, single)
+
+	l.Words = append([]UnitForms(nil), l.Words...)
+	l.Words[Days] = words
+
+	declined := make(map[GrammaticalCase][]UnitForms, len(l.Declensions))
+	for gc, forms := range l.Declensions {
+		declined[gc] = append([]UnitForms(nil), forms...)
+		if f, ok := declensions[gc]; ok {
+			declined[gc][Days] = f
+		}
+	}
+	l.Declensions = declined
+
+	l.Genders = append([]Gender(nil), l.Genders...)
+	l.Genders[Days] = g
+	return l
+}
+
+// replaceString returns a copy of list with the element of the unit u replaced by s.
+func replaceString(list []string, u Unit, s string) []string {
+	list = append([]string(nil), list...)
+	if int(u) < len(list) {
+		list[u] = s
+	}
+	return list
+}
@@ -0,0 +1,42 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithSutki(t *testing.T) {
+	day := 24 * time.Hour
+
+	testTimesWithSutki := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(3*day + 4*time.Hour).WithSutki(), "3 сут. 4 ч."},
+		{Parse(day).WithSutki().Accessible(), "1 сутки"},
+		{Parse(3 * day).WithSutki().Accessible(), "3 суток"},
+		{Parse(21 * day).LimitToUnit(DaysKey).WithSutki().Accessible(), "21 сутки"},
+		{Parse(2 * day).WithSutki().WithGrammaticalCase(Instrumental), "2 сутками"},
+		{Parse(day).WithSutki().WithNumberWords(), "одни сутки"},
+		{Parse(2 * day).WithSutki().WithNumberWords(), "двое суток"},
+		{Parse(21 * day).LimitToUnit(DaysKey).WithSutki().WithNumberWords(), "двадцать одни сутки"},
+		{Parse(22 * day).LimitToUnit(DaysKey).WithSutki().WithNumberWords(), "22 суток"},
+		{Parse(25 * day).LimitToUnit(DaysKey).WithSutki().WithNumberWords(), "двадцать пять суток"},
+		{Parse(112 * day).LimitToUnit(DaysKey).WithSutki().WithNumberWords(), "сто двенадцать суток"},
+		{Parse(134 * day).LimitToUnit(DaysKey).WithSutki().WithNumberWords(), "134 суток"},
+		{Parse(3*day - 4*time.Hour).WithSutki().Fuzzy(FuzzyMedium), "почти 3 суток"},
+		{Parse(3 * day).ClampMax(day).WithSutki(), "более суток"},
+		{Parse(day).WithLocale(English).WithSutki(), "1 day"},
+		{Parse(day), "1 дн."},
+	}
+
+	for _, table := range testTimesWithSutki {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("WithSutki().String() got %q, expected %q", result, table.expected)
+		}
+	}
+
+	if result := Parse(day + time.Hour).WithSutki().Ordinal(); result != "1-е сутки" {
+		t.Errorf("WithSutki().Ordinal() got %q, expected %q", result, "1-е сутки")
+	}
+}
@@ -0,0 +1,98 @@
+package durafmt
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"time"
+)
+
+// durationToken matches a Go duration such as "1m23.456s" preceded by a non-word character.
+var durationToken = regexp.MustCompile(`(^|[^\w.])(-?(?:(?:\d+\.?\d*|\.\d+)(?:ns|us|µs|μs|ms|s|m|h))+)\b`)
+
+// HumanizeInText replaces the Go durations found in s with their human readable form,
+// e.g. "took 1m23.456s" becomes "took 1 мин. 23 сек. 456 млс.", for log post-processing.
+// The options set the output format. Durations formatted to nothing are kept as they are.
+func HumanizeInText(s string, opts ...Option) string {
+	return durationToken.ReplaceAllStringFunc(s, func(match string) string {
+		m := durationToken.FindStringSubmatch(match)
+		duration, err := time.ParseDuration(m[2])
+		if err != nil {
+			return match
+		}
+		human := Parse(duration).Apply(opts...).String()
+		if human == "" {
+			return match
+		}
+		return m[1] + human
+	})
+}
+
+// TextWriter humanizes the Go durations in the lines written through it, see HumanizeInText.
+// Only the incomplete last line is buffered; Flush writes it out.
+type TextWriter struct {
+	w    io.Writer
+	opts []Option
+	line []byte
+}
+
+// NewTextWriter returns a TextWriter writing to w, the options set the output format.
+func NewTextWriter(w io.Writer, opts ...Option) *TextWriter {
+	return &TextWriter{w: w, opts: opts}
+}
+
+// Write humanizes and writes the complete lines of p, buffering the rest until the next newline.
+func (t *TextWriter) Write(p []byte) (int, error) {
+	t.line = append(t.line, p...)
+	i := bytes.LastIndexByte(t.line, '\n')
+	if i < 0 {
+		return len(p), nil
+	}
+	lines := string(t.line[:i+1])
+	t.line = append(t.line[:0], t.line[i+1:]...)
+	if _, err := io.WriteString(t.w, HumanizeInText(lines, t.opts...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush humanizes and writes the buffered incomplete line.
+func (t *TextWriter) Flush() error {
+	if len(t.line) == 0 {
+		return nil
+	}
+	line := string(t.line)
+	t.line = t.line[:0]
+	_, err := io.WriteString(t.w, HumanizeInText(line, t.opts...))
+	return err
+}
+
+// textReader humanizes the Go durations in the lines read from r.
+type textReader struct {
+	r    *bufio.Reader
+	opts []Option
+	line []byte
+	err  error
+}
+
+// NewTextReader returns a reader humanizing the Go durations in the lines read from r,
+// see HumanizeInText. The options set the output format.
+func NewTextReader(r io.Reader, opts ...Option) io.Reader {
+	return &textReader{r: bufio.NewReader(r), opts: opts}
+}
+
+// Read reads the humanized text, one line of r at a time.
+func (t *textReader) Read(p []byte) (int, error) {
+	for len(t.line) == 0 {
+		if t.err != nil {
+			return 0, t.err
+		}
+		var line string
+		line, t.err = t.r.ReadString('\n')
+		t.line = []byte(HumanizeInText(line, t.opts...))
+	}
+	n := copy(p, t.line)
+	t.line = t.line[n:]
+	return n, nil
+}
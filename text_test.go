@@ -0,0 +1,56 @@
+package durafmt
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestHumanizeInText(t *testing.T) {
+	testHumanizeInText := []struct {
+		input    string
+		expected string
+	}{
+		{"took 1m23.456s", "took 1 мин. 23 сек. 456 млс."},
+		{"request done in 250ms, retry after 2h30m.", "request done in 250 млс., retry after 2 ч. 30 мин.."},
+		{"-5s,10µs", "-5 сек.,10 мкс."},
+		{"5min v1.2s x5s 0s", "5min v1.2s x5s 0s"},
+	}
+
+	for _, table := range testHumanizeInText {
+		if result := HumanizeInText(table.input); result != table.expected {
+			t.Errorf("HumanizeInText(%q) got %q, expected %q", table.input, result, table.expected)
+		}
+	}
+}
+
+func TestTextWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewTextWriter(&b)
+	for _, chunk := range []string{"took 1", "m5s\nwait ", "3s"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := "took 1 мин. 5 сек.\n"; b.String() != expected {
+		t.Errorf("TextWriter.Write() got %q, expected %q", b.String(), expected)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "took 1 мин. 5 сек.\nwait 3 сек."; b.String() != expected {
+		t.Errorf("TextWriter.Flush() got %q, expected %q", b.String(), expected)
+	}
+}
+
+func TestTextReader(t *testing.T) {
+	input := "took 1m5s\nwait 3s"
+	result, err := ioutil.ReadAll(NewTextReader(iotest.OneByteReader(strings.NewReader(input))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "took 1 мин. 5 сек.\nwait 3 сек."; string(result) != expected {
+		t.Errorf("NewTextReader(%q) got %q, expected %q", input, result, expected)
+	}
+}
@@ -0,0 +1,61 @@
+package durafmt
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Timecode formats the duration as a SMPTE timecode "HH:MM:SS:FF" of frames at fps,
+// e.g. "01:02:03:12" at 25 fps. The NTSC rates 29.97 and 59.94 use drop-frame
+// timecode, "00:10:00;00", other NTSC rates such as 23.976 count frames at the nominal rate.
+// Hours are not wrapped at 24. A rate not greater than zero gives "".
+func (d *Durafmt) Timecode(fps float64) string {
+	if !(fps > 0) || math.IsInf(fps, 0) {
+		return ""
+	}
+
+	// frames = ns * num / (den * 1e9), in 128 bits to not overflow.
+	nominal := uint64(math.Round(fps))
+	num, den := uint64(math.Round(fps*1000)), uint64(1000)
+	ntsc := nominal > 0 && math.Abs(fps-float64(nominal)*1000/1001) < 0.005
+	if ntsc {
+		num, den = nominal*1000, 1001
+	}
+	if nominal == 0 {
+		nominal = 1
+	}
+	duration := d.Duration()
+	hi, lo := bits.Mul64(uint64(abs(duration)), num)
+	frames, _ := bits.Div64(hi, lo, den*1e9)
+
+	separator := ":"
+	if ntsc && (nominal == 30 || nominal == 60) {
+		separator = ";"
+		frames = dropFrames(frames, nominal)
+	}
+
+	perHour := nominal * 3600
+	s := twoDigits(int64(frames/perHour)) + ":" +
+		twoDigits(int64(frames%perHour/(nominal*60))) + ":" +
+		twoDigits(int64(frames%(nominal*60)/nominal)) + separator +
+		twoDigits(int64(frames%nominal))
+	if duration < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// dropFrames returns the frame count at the nominal rate, 30 or 60, skipping the frame numbers
+// dropped by the drop-frame timecode: the first 2 or 4 of every minute except each tenth.
+func dropFrames(frames, nominal uint64) uint64 {
+	drop := nominal / 15
+	perMinute := nominal*60 - drop
+	perTenMinutes := perMinute*10 + drop
+
+	tens, rest := frames/perTenMinutes, frames%perTenMinutes
+	frames += drop * 9 * tens
+	if rest > drop {
+		frames += drop * ((rest - drop) / perMinute)
+	}
+	return frames
+}
@@ -0,0 +1,30 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimecode(t *testing.T) {
+	testTimecode := []struct {
+		test     time.Duration
+		fps      float64
+		expected string
+	}{
+		{time.Hour + 2*time.Minute + 3480*time.Millisecond, 25, "01:02:03:12"},
+		{1500 * time.Millisecond, 24, "00:00:01:12"},
+		{time.Minute, 29.97, "00:00:59;28"},
+		{10 * time.Minute, 29.97, "00:10:00;00"},
+		{time.Hour, 29.97, "01:00:00;00"},
+		{time.Minute + 100*time.Millisecond, 59.94, "00:01:00;06"},
+		{time.Minute, 23.976, "00:00:59:22"},
+		{-2 * time.Second, 30, "-00:00:02:00"},
+		{time.Second, 0, ""},
+	}
+
+	for _, table := range testTimecode {
+		if result := Parse(table.test).Timecode(table.fps); result != table.expected {
+			t.Errorf("Parse(%v).Timecode(%v) got %q, expected %q", table.test, table.fps, result, table.expected)
+		}
+	}
+}
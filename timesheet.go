@@ -0,0 +1,41 @@
+package durafmt
+
+import "time"
+
+// DecimalHours formats the duration as decimal hours with places decimals, rounded half up,
+// e.g. "2,50 ч" or "2.5 h" with the locale decimal separator, the way time-tracking
+// and billing exports show durations.
+func (d *Durafmt) DecimalHours(places int) string {
+	if places < 0 {
+		places = 0
+	}
+	if places > 9 {
+		places = 9
+	}
+	c := d.Clone()
+	c.decimalPlaces = places
+	c.decimalUnit = Hours
+	hours := c.decimalComponent()
+
+	locale := c.Locale()
+	s := c.formatNumber(hours.value)
+	if places > 0 {
+		s += locale.DecimalSeparator + TransliterateDigits(hours.fraction, locale.Digits)
+	}
+	if d.Duration() < 0 {
+		s = "-" + s
+	}
+	return s + " " + locale.Compact[Hours]
+}
+
+// IndustrialHours formats the duration in industrial time, hours with centesimal fractions,
+// e.g. "7,75 ч" for 7h45m, as manufacturing and payroll systems record working time.
+func (d *Durafmt) IndustrialHours() string {
+	return d.DecimalHours(2)
+}
+
+// IndustrialMinutes returns the duration in industrial minutes, hundredths of an hour
+// rounded half up, e.g. 775 for 7h45m.
+func (d *Durafmt) IndustrialMinutes() int64 {
+	return int64(d.Duration().Round(36*time.Second) / (36 * time.Second))
+}
@@ -0,0 +1,54 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecimalHours(t *testing.T) {
+	testDecimalHours := []struct {
+		test     time.Duration
+		places   int
+		locale   Locale
+		expected string
+	}{
+		{2*time.Hour + 30*time.Minute, 2, Russian, "2,50 ч"},
+		{2*time.Hour + 30*time.Minute, 1, English, "2.5 h"},
+		{2*time.Hour + 20*time.Minute, 2, Russian, "2,33 ч"},
+		{2*time.Hour + 59*time.Minute + 59*time.Second, 2, Russian, "3,00 ч"},
+		{2*time.Hour + 30*time.Minute, 0, Russian, "3 ч"},
+		{-45 * time.Minute, 2, English, "-0.75 h"},
+		{0, 2, Russian, "0,00 ч"},
+	}
+
+	for _, table := range testDecimalHours {
+		result := Parse(table.test).WithLocale(table.locale).DecimalHours(table.places)
+		if result != table.expected {
+			t.Errorf("Parse(%q).DecimalHours(%d) got %q, expected %q", table.test, table.places, result, table.expected)
+		}
+	}
+}
+
+func TestIndustrialTime(t *testing.T) {
+	testIndustrialTime := []struct {
+		test     time.Duration
+		expected string
+		minutes  int64
+	}{
+		{7*time.Hour + 45*time.Minute, "7,75 ч", 775},
+		{8*time.Hour + 10*time.Minute, "8,17 ч", 817},
+		{-(time.Hour + 30*time.Minute), "-1,50 ч", -150},
+		{17 * time.Second, "0,00 ч", 0},
+		{18 * time.Second, "0,01 ч", 1},
+	}
+
+	for _, table := range testIndustrialTime {
+		d := Parse(table.test)
+		if result := d.IndustrialHours(); result != table.expected {
+			t.Errorf("Parse(%q).IndustrialHours() got %q, expected %q", table.test, result, table.expected)
+		}
+		if result := d.IndustrialMinutes(); result != table.minutes {
+			t.Errorf("Parse(%q).IndustrialMinutes() got %d, expected %d", table.test, result, table.minutes)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package durafmt
+
+import "time"
+
+// Twitter is the ultra-compact style of timelines and badges, a single token chosen
+// by magnitude, e.g. "12с", "5м", "3ч", "2д" or "1г". It is registered as "twitter".
+var Twitter Style = StyleFunc(formatTwitter)
+
+func init() {
+	RegisterStyle("twitter", Twitter)
+}
+
+// formatTwitter formats d as a single compact token.
+func formatTwitter(d *Durafmt) string {
+	duration := abs(d.duration)
+
+	var c component
+	switch {
+	case duration < time.Minute:
+		c = component{unit: Seconds, value: int64(duration / time.Second)}
+	case duration < time.Hour:
+		c = component{unit: Minutes, value: int64(duration / time.Minute)}
+	case duration < 24*time.Hour:
+		c = component{unit: Hours, value: int64(duration / time.Hour)}
+	case duration < 365*24*time.Hour:
+		c = component{unit: Days, value: int64(duration / (24 * time.Hour))}
+	default:
+		c = component{unit: Years, value: int64(duration / (365 * 24 * time.Hour))}
+	}
+	return applyCase(d.renderCompact(c), d.letterCase)
+}
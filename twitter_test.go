@@ -0,0 +1,31 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTwitter(t *testing.T) {
+	testTimesTwitter := []struct {
+		test     time.Duration
+		locale   Locale
+		expected string
+	}{
+		{500 * time.Millisecond, Russian, "0с"},
+		{12 * time.Second, Russian, "12с"},
+		{5*time.Minute + 59*time.Second, Russian, "5м"},
+		{3*time.Hour + 30*time.Minute, Russian, "3ч"},
+		{-2 * 24 * time.Hour, Russian, "2д"},
+		{30 * 24 * time.Hour, Russian, "30д"},
+		{400 * 24 * time.Hour, Russian, "1г"},
+		{3 * time.Hour, English, "3h"},
+	}
+
+	for _, table := range testTimesTwitter {
+		result := Parse(table.test).WithLocale(table.locale).WithStyle("twitter").String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).WithStyle(\"twitter\").String() got %q, expected %q",
+				table.test, result, table.expected)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package durafmt
+
+import (
+	"errors"
+	"strings"
+)
+
+// Unit is a unit of time a duration is split into, ordered from the biggest to the smallest.
+type Unit int
+
+const (
+	Years Unit = iota
+	Weeks
+	Days
+	Hours
+	Minutes
+	Seconds
+	Milliseconds
+	Microseconds
+	// Nanoseconds are only displayed WithNanoseconds.
+	Nanoseconds
+)
+
+var unitNames = []string{"years", "weeks", "days", "hours", "minutes", "seconds", "milliseconds", "microseconds", "nanoseconds"}
+
+// String returns the English name of u, e.g. "hours".
+func (u Unit) String() string {
+	if u < Years || u > Nanoseconds {
+		return "unknown"
+	}
+	return unitNames[u]
+}
+
+// ParseUnit returns the unit named by its English identifier, singular or plural
+// and in any letter case, e.g. "hours", "Day" or "minute", as returned by Unit.String.
+// It lets LimitToUnit, LimitTo and SkipUnits be configured without the locale labels.
+func ParseUnit(name string) (Unit, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, n := range unitNames {
+		if name == n || name == strings.TrimSuffix(n, "s") {
+			return Unit(i), nil
+		}
+	}
+	return 0, errors.New("durafmt: unknown unit " + name)
+}
+
+// MarshalText encodes u as its English name, so units read well in JSON and YAML configs.
+func (u Unit) MarshalText() ([]byte, error) {
+	if u < Years || u > Nanoseconds {
+		return nil, errors.New("durafmt: unknown unit " + u.String())
+	}
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText decodes u from an English name accepted by ParseUnit.
+func (u *Unit) UnmarshalText(text []byte) error {
+	v, err := ParseUnit(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// UnitValue is a displayed unit of a duration and its value.
+type UnitValue struct {
+	Unit  Unit
+	Value int64
+}
+
+// SkipUnits sets the output format, never displaying the units, their amount is carried
+// to the next smaller unit, e.g. SkipUnits(Weeks) gives "17 дн." instead of "2 нед. 3 дн.".
+// The smallest displayed unit can not be skipped.
+func (d *Durafmt) SkipUnits(units ...Unit) *Durafmt {
+	c := d.Clone()
+	for _, u := range units {
+		c.skipUnits |= 1 << uint(u)
+	}
+	return c
+}
+
+// skipped reports whether u is never displayed.
+func (d *Durafmt) skipped(u Unit) bool {
+	return d.skipUnits&(1<<uint(u)) != 0
+}
+
+// Units returns the units of the duration and their values, from the biggest to the smallest.
+// Unlike the output, the units always go down to the nanosecond remainder,
+// so without a limit they add up to the original duration.
+func (d *Durafmt) Units() []UnitValue {
+	c := d.Clone()
+	c.nanoseconds = true
+	components := c.components()
+	list := make([]UnitValue, len(components))
+	for i, c := range components {
+		list[i] = UnitValue{c.unit, c.value}
+	}
+	return list
+}
+
+// Map returns the displayed units of the duration and their values, keyed by unit.
+func (d *Durafmt) Map() map[Unit]int64 {
+	components := d.components()
+	m := make(map[Unit]int64, len(components))
+	for _, c := range components {
+		m[c.unit] = c.value
+	}
+	return m
+}
+
+// Range calls f for every non-zero displayed unit of the duration and its value,
+// from the biggest to the smallest. If f returns false, Range stops the iteration.
+func (d *Durafmt) Range(f func(u Unit, v int64) bool) {
+	for _, c := range d.components() {
+		if c.value == 0 {
+			continue
+		}
+		if !f(c.unit, c.value) {
+			return
+		}
+	}
+}
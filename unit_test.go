@@ -0,0 +1,132 @@
+package durafmt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSkipUnits(t *testing.T) {
+	testTimesSkipUnits := []struct {
+		test     time.Duration
+		skip     []Unit
+		expected string
+	}{
+		{17 * 24 * time.Hour, nil, "2 нед. 3 дн."},
+		{17 * 24 * time.Hour, []Unit{Weeks}, "17 дн."},
+		{400 * 24 * time.Hour, []Unit{Years, Weeks}, "400 дн."},
+		{time.Hour + 1500*time.Microsecond, []Unit{Milliseconds}, "1 ч. 1500 мкс."},
+		{5 * time.Microsecond, []Unit{Microseconds}, "5 мкс."},
+	}
+
+	for _, table := range testTimesSkipUnits {
+		result := Parse(table.test).SkipUnits(table.skip...).String()
+		if result != table.expected {
+			t.Errorf("Parse(%q).SkipUnits(%v).String() got %q, expected %q",
+				table.test, table.skip, result, table.expected)
+		}
+	}
+}
+
+func TestUnits(t *testing.T) {
+	result := Parse(-(2*time.Hour + 30*time.Minute)).Units()
+	expected := []UnitValue{{Hours, 2}, {Minutes, 30}}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Units() got %v, expected %v", result, expected)
+	}
+
+	if result := Hours.String(); result != "hours" {
+		t.Errorf("Hours.String() got %q, expected %q", result, "hours")
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	testParseUnit := []struct {
+		name     string
+		expected Unit
+		err      bool
+	}{
+		{"hours", Hours, false},
+		{"Day", Days, false},
+		{" MINUTES ", Minutes, false},
+		{"microsecond", Microseconds, false},
+		{"ч.", 0, true},
+		{"", 0, true},
+	}
+
+	for _, table := range testParseUnit {
+		result, err := ParseUnit(table.name)
+		if (err != nil) != table.err || result != table.expected {
+			t.Errorf("ParseUnit(%q) got %v, %v, expected %v", table.name, result, err, table.expected)
+		}
+	}
+
+	unit, _ := ParseUnit("minutes")
+	if result := Parse(2 * time.Hour).LimitTo(unit).String(); result != "120 мин." {
+		t.Errorf("LimitTo(ParseUnit(%q)) got %q, expected %q", "minutes", result, "120 мин.")
+	}
+
+	testLimitToUnit := []struct {
+		unit     string
+		expected string
+	}{
+		{"minutes", "120 мин."},
+		{"Minute", "120 мин."},
+		{MinutesKey, "120 мин."},
+		{"seconds", "7200 сек."},
+		{"", "2 ч."},
+		{"fortnights", "2 ч."},
+	}
+	for _, table := range testLimitToUnit {
+		if result := Parse(2 * time.Hour).LimitToUnit(table.unit).String(); result != table.expected {
+			t.Errorf("LimitToUnit(%q) got %q, expected %q", table.unit, result, table.expected)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	result := Parse(26*time.Hour + 5*time.Second).Map()
+	expected := map[Unit]int64{Days: 1, Hours: 2, Seconds: 5}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Map() got %v, expected %v", result, expected)
+	}
+}
+
+func TestRange(t *testing.T) {
+	var result []UnitValue
+	Parse(26*time.Hour + 5*time.Second).Range(func(u Unit, v int64) bool {
+		result = append(result, UnitValue{u, v})
+		return u != Hours
+	})
+	expected := []UnitValue{{Days, 1}, {Hours, 2}}
+	if fmt.Sprint(result) != fmt.Sprint(expected) {
+		t.Errorf("Range() got %v, expected %v", result, expected)
+	}
+}
+
+func TestWithNanoseconds(t *testing.T) {
+	testTimesNanoseconds := []struct {
+		test     *Durafmt
+		expected string
+	}{
+		{Parse(1500 * time.Nanosecond), "1 мкс."},
+		{Parse(1500 * time.Nanosecond).WithNanoseconds(), "1 мкс. 500 нс."},
+		{Parse(-time.Second - 7*time.Nanosecond).WithNanoseconds(), "-1 сек. 7 нс."},
+		{Parse(2500 * time.Nanosecond).WithNanoseconds().WithLocale(English), "2 microseconds 500 nanoseconds"},
+	}
+
+	for _, table := range testTimesNanoseconds {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("WithNanoseconds() got %q, expected %q", result, table.expected)
+		}
+	}
+
+	duration := 3*time.Hour + 4*time.Millisecond + 5*time.Nanosecond
+	var sum time.Duration
+	for _, uv := range Parse(duration).Units() {
+		sum += time.Duration(uv.Value) * uv.Unit.Duration()
+	}
+	if sum != duration {
+		t.Errorf("Units() add up to %v, expected %v", sum, duration)
+	}
+}
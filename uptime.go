@@ -0,0 +1,45 @@
+package durafmt
+
+import (
+	"context"
+	"time"
+)
+
+// processStart is the time the package was initialized, close to the start of the process.
+var processStart = time.Now()
+
+// ProcessUptime returns the time since the process started, e.g. for status endpoints.
+// The start is taken when the package is initialized.
+func ProcessUptime() *Durafmt {
+	return Since(processStart)
+}
+
+// UptimeTicker returns a channel receiving the human readable time since start every interval,
+// e.g. for status pages and dashboards. Like time.Tick it never stops,
+// use UptimeTickerContext to release it.
+func UptimeTicker(start time.Time, interval time.Duration, opts ...Option) <-chan string {
+	return UptimeTickerContext(context.Background(), start, interval, opts...)
+}
+
+// UptimeTickerContext is UptimeTicker stopping and closing the channel once ctx is done.
+// Like time.Ticker it drops the uptimes a slow receiver is not ready for.
+func UptimeTickerContext(ctx context.Context, start time.Time, interval time.Duration, opts ...Option) <-chan string {
+	ch := make(chan string, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				select {
+				case ch <- Since(start).Apply(opts...).String():
+				default:
+				}
+			}
+		}
+	}()
+	return ch
+}
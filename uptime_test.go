@@ -0,0 +1,33 @@
+package durafmt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestUptimeTickerContext(t *testing.T) {
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start.Add(90 * time.Minute) }
+	defer func() { now = time.Now }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := UptimeTickerContext(ctx, start, time.Millisecond)
+
+	if result, expected := <-ch, "1 ч. 30 мин."; result != expected {
+		t.Errorf("UptimeTickerContext() got %q, expected %q", result, expected)
+	}
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestProcessUptime(t *testing.T) {
+	now = func() time.Time { return processStart.Add(3 * time.Hour) }
+	defer func() { now = time.Now }()
+
+	if result, expected := ProcessUptime().String(), "3 ч."; result != expected {
+		t.Errorf("ProcessUptime() got %q, expected %q", result, expected)
+	}
+}
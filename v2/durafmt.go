@@ -0,0 +1,77 @@
+// Package durafmt formats time.Duration into a human readable format.
+//
+// Unlike v1, Durafmt is an immutable value: the output format is set at construction
+// with options, and copies can be passed around without one changing another.
+package durafmt
+
+import (
+	"time"
+
+	v1 "github.com/ihippik/durafmt"
+)
+
+// Option sets the output format of a Durafmt, the v1 options are accepted as they are.
+type Option = v1.Option
+
+// Durafmt is a duration with its output format. The zero value formats a zero duration.
+type Durafmt struct {
+	f *v1.Durafmt // The options applied once at construction, never changed afterwards.
+}
+
+// New creates a Durafmt formatting the duration with the options applied in order.
+func New(duration time.Duration, opts ...Option) Durafmt {
+	return Durafmt{f: v1.Parse(duration).Apply(opts...)}
+}
+
+// FromV1 converts a v1 *Durafmt, keeping its duration and output format,
+// so v1 call sites can move to the value API one at a time.
+func FromV1(d *v1.Durafmt) Durafmt {
+	if d == nil {
+		return Durafmt{}
+	}
+	return Durafmt{f: d.Clone()}
+}
+
+// V1 returns the v1 *Durafmt of d, for the APIs not ported to v2 yet.
+// The result is a fresh copy, changing it does not affect d.
+func (d Durafmt) V1() *v1.Durafmt {
+	return d.resolved().Clone()
+}
+
+// With returns a copy of d with more options applied after its own.
+func (d Durafmt) With(opts ...Option) Durafmt {
+	return Durafmt{f: d.V1().Apply(opts...)}
+}
+
+// Duration returns the formatted duration.
+func (d Durafmt) Duration() time.Duration {
+	return d.resolved().Duration()
+}
+
+// String returns the human readable duration. It depends only on d.
+func (d Durafmt) String() string {
+	return d.resolved().String()
+}
+
+// resolved returns the v1 *Durafmt of d, not to be changed.
+func (d Durafmt) resolved() *v1.Durafmt {
+	if d.f == nil {
+		return v1.Parse(0)
+	}
+	return d.f
+}
+
+// LimitFirstN outputs only the first n units, n == 0 means no limit.
+func LimitFirstN(n int) Option {
+	return func(d *v1.Durafmt) *v1.Durafmt { return d.LimitFirstN(n) }
+}
+
+// LimitToUnit outputs no unit bigger than u, v1.Years means no restriction.
+func LimitToUnit(u v1.Unit) Option {
+	return func(d *v1.Durafmt) *v1.Durafmt { return d.LimitTo(u) }
+}
+
+// WithLocale formats the output with the locale.
+func WithLocale(l v1.Locale) Option {
+	return func(d *v1.Durafmt) *v1.Durafmt { return d.WithLocale(l) }
+}
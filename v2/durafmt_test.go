@@ -0,0 +1,37 @@
+package durafmt
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/ihippik/durafmt"
+)
+
+func TestDurafmt(t *testing.T) {
+	base := New(2*time.Hour+30*time.Minute, WithLocale(v1.English))
+	opts := []Option{LimitFirstN(1)}
+	short := base.With(opts...)
+	opts[0] = LimitToUnit(v1.Minutes)
+
+	testDurafmts := []struct {
+		test     Durafmt
+		expected string
+	}{
+		{base, "2 hours 30 minutes"},
+		{short, "2 hours"},
+		{base.With(opts...), "150 minutes"},
+		{Durafmt{}, v1.Format(0)},
+		{FromV1(v1.Parse(time.Hour).LimitTo(v1.Minutes)), "60 мин."},
+		{FromV1(v1.Parse(time.Hour)).With(LimitToUnit(v1.Seconds)), "3600 сек."},
+	}
+
+	for _, table := range testDurafmts {
+		if result := table.test.String(); result != table.expected {
+			t.Errorf("String() got %q, expected %q", result, table.expected)
+		}
+	}
+
+	if result := base.V1().LimitFirstN(1).String(); result != "2 hours" || base.String() != "2 hours 30 minutes" {
+		t.Errorf("V1() changed the value, got %q", base.String())
+	}
+}
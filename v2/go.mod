@@ -0,0 +1,5 @@
+module github.com/ihippik/durafmt/v2
+
+go 1.14
+
+require github.com/ihippik/durafmt v0.0.0
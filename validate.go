@@ -0,0 +1,160 @@
+package durafmt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Problem is an issue of a locale found by ValidateLocale.
+type Problem struct {
+	// Field is the locale field at fault, e.g. "Words[hours]".
+	Field string
+	// Message describes the issue, e.g. "no few form".
+	Message string
+}
+
+// String returns the problem as "field: message".
+func (p Problem) String() string {
+	return p.Field + ": " + p.Message
+}
+
+// ValidateLocale reports the missing plural forms, the unit tables of the wrong size
+// and the missing or malformed phrases of l, so new translations are checked before
+// they silently produce wrong output. It returns nil for a complete locale.
+func ValidateLocale(l Locale) []Problem {
+	var problems []Problem
+	report := func(field, format string, a ...interface{}) {
+		problems = append(problems, Problem{Field: field, Message: fmt.Sprintf(format, a...)})
+	}
+
+	if l.Name == "" {
+		report("Name", "empty")
+	}
+	n := int(Nanoseconds) + 1
+	for _, table := range []struct {
+		field string
+		names []string
+	}{
+		{"Units", l.Units},
+		{"Compact", l.Compact},
+		{"SingleUnits", l.SingleUnits},
+	} {
+		if len(table.names) != n {
+			report(table.field, "%d entries, expected %d", len(table.names), n)
+			continue
+		}
+		for u, name := range table.names {
+			if name == "" {
+				report(fmt.Sprintf("%s[%s]", table.field, Unit(u)), "empty")
+			}
+		}
+	}
+
+	for _, field := range []struct {
+		name, value string
+	}{
+		{"GroupSeparator", l.GroupSeparator},
+		{"DecimalSeparator", l.DecimalSeparator},
+		{"ListSeparator", l.ListSeparator},
+		{"Docker.LessThanASecond", l.Docker.LessThanASecond},
+		{"Docker.AboutAMinute", l.Docker.AboutAMinute},
+		{"Docker.AboutAnHour", l.Docker.AboutAnHour},
+		{"Docker.Months", l.Docker.Months},
+	} {
+		if field.value == "" {
+			report(field.name, "empty")
+		}
+	}
+	for _, pattern := range []struct {
+		name, value string
+		verbs       int
+	}{
+		{"Remaining", l.Remaining, 1},
+		{"Overdue", l.Overdue, 1},
+		{"MoreThan", l.MoreThan, 1},
+		{"LessThan", l.LessThan, 1},
+		{"Segments", l.Segments, 3},
+		{"SegmentsRest", l.SegmentsRest, 1},
+		{"Docker.Ago", l.Docker.Ago, 1},
+	} {
+		if verbs := strings.Count(pattern.value, "%s"); verbs != pattern.verbs {
+			report(pattern.name, "%d %%s verbs in %q, expected %d", verbs, pattern.value, pattern.verbs)
+		}
+	}
+	if l.Digits != "" && utf8.RuneCountInString(l.Digits) != 10 {
+		report("Digits", "%d digits, expected 10", utf8.RuneCountInString(l.Digits))
+	}
+
+	categories := pluralCategories(l)
+	checkForms := func(field string, forms UnitForms) {
+		for _, c := range categories {
+			if _, ok := forms[c]; !ok {
+				report(field, "no %s form", c)
+			}
+		}
+	}
+	if l.UnitPlurals != nil && len(l.UnitPlurals) != n {
+		report("UnitPlurals", "%d entries, expected %d", len(l.UnitPlurals), n)
+	} else {
+		for u, forms := range l.UnitPlurals {
+			if forms == nil {
+				continue
+			}
+			if int(u) < len(l.Units) && !forms.has(l.Units[u]) {
+				report(fmt.Sprintf("UnitPlurals[%s]", Unit(u)), "no form is the unit name %q", l.Units[u])
+				continue
+			}
+			checkForms(fmt.Sprintf("UnitPlurals[%s]", Unit(u)), forms)
+		}
+	}
+	if l.Words == nil {
+		report("Words", "none")
+	} else if len(l.Words) != n {
+		report("Words", "%d entries, expected %d", len(l.Words), n)
+	} else {
+		for u, forms := range l.Words {
+			checkForms(fmt.Sprintf("Words[%s]", Unit(u)), forms)
+		}
+	}
+	cases := make([]GrammaticalCase, 0, len(l.Declensions))
+	for gc := range l.Declensions {
+		cases = append(cases, gc)
+	}
+	sort.Slice(cases, func(i, j int) bool { return cases[i] < cases[j] })
+	for _, gc := range cases {
+		declined := l.Declensions[gc]
+		if len(declined) != n {
+			report(fmt.Sprintf("Declensions[%d]", gc), "%d entries, expected %d", len(declined), n)
+			continue
+		}
+		for u, forms := range declined {
+			checkForms(fmt.Sprintf("Declensions[%d][%s]", gc, Unit(u)), forms)
+		}
+	}
+	checkForms("Interval", l.Interval)
+	return problems
+}
+
+// pluralCategories returns the plural categories of l, found by sampling its plural rules
+// on the numbers the CLDR rules tell apart, in the CLDR order and with PluralOther last.
+func pluralCategories(l Locale) []PluralCategory {
+	found := make(map[PluralCategory]bool)
+	if l.Plural != nil {
+		for n := int64(0); n <= 1000; n++ {
+			found[l.plural(n)] = true
+		}
+		for n := int64(10000); n <= 1e18; n *= 10 {
+			found[l.plural(n)] = true
+		}
+	}
+
+	var categories []PluralCategory
+	for _, c := range pluralOrder {
+		if found[c] {
+			categories = append(categories, c)
+		}
+	}
+	return append(categories, PluralOther)
+}
@@ -0,0 +1,46 @@
+package durafmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateLocale(t *testing.T) {
+	for _, l := range []Locale{Russian, English, Ukrainian} {
+		if problems := ValidateLocale(l); problems != nil {
+			t.Errorf("ValidateLocale(%s) got %q, expected none", l.Name, problems)
+		}
+	}
+
+	broken := Russian
+	broken.Name = ""
+	broken.Compact = broken.Compact[:3]
+	broken.Words = append([]UnitForms(nil), broken.Words...)
+	broken.Words[Hours] = UnitForms{PluralOne: "час", PluralOther: "часа"}
+	broken.Overdue = "просрочено"
+	broken.Digits = "0123"
+	broken.Interval = nil
+	broken.UnitPlurals = make([]UnitForms, 9)
+	broken.UnitPlurals[Hours] = UnitForms{PluralOther: "часов"}
+
+	expected := []string{
+		"Name: empty",
+		"Compact: 3 entries, expected 9",
+		"Overdue: 0 %s verbs in \"просрочено\", expected 1",
+		"Digits: 4 digits, expected 10",
+		"UnitPlurals[hours]: no form is the unit name \"ч.\"",
+		"Words[hours]: no few form",
+		"Words[hours]: no many form",
+		"Interval: no one form",
+		"Interval: no few form",
+		"Interval: no many form",
+		"Interval: no other form",
+	}
+	var result []string
+	for _, p := range ValidateLocale(broken) {
+		result = append(result, p.String())
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ValidateLocale() got %q, expected %q", result, expected)
+	}
+}
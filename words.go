@@ -0,0 +1,81 @@
+package durafmt
+
+// russianUnitPlurals holds the forms of the Russian unit abbreviations, only years decline,
+// "1 год", "2 года", "5 лет" and "1,5 года".
+var russianUnitPlurals = []UnitForms{
+	{PluralOne: "год", PluralFew: "года", PluralMany: "лет", PluralOther: "года"},
+	nil, nil, nil, nil, nil, nil, nil, nil,
+}
+
+// russianWords holds the full Russian unit nouns in the nominative case, ordered from years to nanoseconds.
+// The PluralOther form follows fractions, "1,5 часа".
+var russianWords = []UnitForms{
+	{PluralOne: "год", PluralFew: "года", PluralMany: "лет", PluralOther: "года"},
+	{PluralOne: "неделя", PluralFew: "недели", PluralMany: "недель", PluralOther: "недели"},
+	{PluralOne: "день", PluralFew: "дня", PluralMany: "дней", PluralOther: "дня"},
+	{PluralOne: "час", PluralFew: "часа", PluralMany: "часов", PluralOther: "часа"},
+	{PluralOne: "минута", PluralFew: "минуты", PluralMany: "минут", PluralOther: "минуты"},
+	{PluralOne: "секунда", PluralFew: "секунды", PluralMany: "секунд", PluralOther: "секунды"},
+	{PluralOne: "миллисекунда", PluralFew: "миллисекунды", PluralMany: "миллисекунд", PluralOther: "миллисекунды"},
+	{PluralOne: "микросекунда", PluralFew: "микросекунды", PluralMany: "микросекунд", PluralOther: "микросекунды"},
+	{PluralOne: "наносекунда", PluralFew: "наносекунды", PluralMany: "наносекунд", PluralOther: "наносекунды"},
+}
+
+// russianGenders holds the grammatical gender of the Russian unit nouns.
+var russianGenders = []Gender{Masculine, Feminine, Masculine, Masculine, Feminine, Feminine, Feminine, Feminine, Feminine}
+
+// russianDeclensions holds the full Russian unit nouns in the other cases than the nominative.
+var russianDeclensions = map[GrammaticalCase][]UnitForms{
+	Genitive: russianGenitive,
+	Accusative: {
+		{PluralOne: "год", PluralFew: "года", PluralMany: "лет", PluralOther: "года"},
+		{PluralOne: "неделю", PluralFew: "недели", PluralMany: "недель", PluralOther: "недели"},
+		{PluralOne: "день", PluralFew: "дня", PluralMany: "дней", PluralOther: "дня"},
+		{PluralOne: "час", PluralFew: "часа", PluralMany: "часов", PluralOther: "часа"},
+		{PluralOne: "минуту", PluralFew: "минуты", PluralMany: "минут", PluralOther: "минуты"},
+		{PluralOne: "секунду", PluralFew: "секунды", PluralMany: "секунд", PluralOther: "секунды"},
+		{PluralOne: "миллисекунду", PluralFew: "миллисекунды", PluralMany: "миллисекунд", PluralOther: "миллисекунды"},
+		{PluralOne: "микросекунду", PluralFew: "микросекунды", PluralMany: "микросекунд", PluralOther: "микросекунды"},
+		{PluralOne: "наносекунду", PluralFew: "наносекунды", PluralMany: "наносекунд", PluralOther: "наносекунды"},
+	},
+	Instrumental: {
+		{PluralOne: "годом", PluralFew: "годами", PluralMany: "годами", PluralOther: "года"},
+		{PluralOne: "неделей", PluralFew: "неделями", PluralMany: "неделями", PluralOther: "недели"},
+		{PluralOne: "днём", PluralFew: "днями", PluralMany: "днями", PluralOther: "дня"},
+		{PluralOne: "часом", PluralFew: "часами", PluralMany: "часами", PluralOther: "часа"},
+		{PluralOne: "минутой", PluralFew: "минутами", PluralMany: "минутами", PluralOther: "минуты"},
+		{PluralOne: "секундой", PluralFew: "секундами", PluralMany: "секундами", PluralOther: "секунды"},
+		{PluralOne: "миллисекундой", PluralFew: "миллисекундами", PluralMany: "миллисекундами", PluralOther: "миллисекунды"},
+		{PluralOne: "микросекундой", PluralFew: "микросекундами", PluralMany: "микросекундами", PluralOther: "микросекунды"},
+		{PluralOne: "наносекундой", PluralFew: "наносекундами", PluralMany: "наносекундами", PluralOther: "наносекунды"},
+	},
+}
+
+// russianGenitive holds the full Russian unit nouns in the genitive case, "около 3 дней".
+var russianGenitive = []UnitForms{
+	{PluralOne: "года", PluralFew: "лет", PluralMany: "лет", PluralOther: "года"},
+	{PluralOne: "недели", PluralFew: "недель", PluralMany: "недель", PluralOther: "недели"},
+	{PluralOne: "дня", PluralFew: "дней", PluralMany: "дней", PluralOther: "дня"},
+	{PluralOne: "часа", PluralFew: "часов", PluralMany: "часов", PluralOther: "часа"},
+	{PluralOne: "минуты", PluralFew: "минут", PluralMany: "минут", PluralOther: "минуты"},
+	{PluralOne: "секунды", PluralFew: "секунд", PluralMany: "секунд", PluralOther: "секунды"},
+	{PluralOne: "миллисекунды", PluralFew: "миллисекунд", PluralMany: "миллисекунд", PluralOther: "миллисекунды"},
+	{PluralOne: "микросекунды", PluralFew: "микросекунд", PluralMany: "микросекунд", PluralOther: "микросекунды"},
+	{PluralOne: "наносекунды", PluralFew: "наносекунд", PluralMany: "наносекунд", PluralOther: "наносекунды"},
+}
+
+// englishWords holds the full English unit nouns, ordered from years to nanoseconds.
+var englishWords = []UnitForms{
+	{PluralOne: "year", PluralOther: "years"},
+	{PluralOne: "week", PluralOther: "weeks"},
+	{PluralOne: "day", PluralOther: "days"},
+	{PluralOne: "hour", PluralOther: "hours"},
+	{PluralOne: "minute", PluralOther: "minutes"},
+	{PluralOne: "second", PluralOther: "seconds"},
+	{PluralOne: "millisecond", PluralOther: "milliseconds"},
+	{PluralOne: "microsecond", PluralOther: "microseconds"},
+	{PluralOne: "nanosecond", PluralOther: "nanoseconds"},
+}
+
+// englishSingleUnits holds the English phrases of exactly one unit, "more than a year".
+var englishSingleUnits = []string{"a year", "a week", "a day", "an hour", "a minute", "a second", "a millisecond", "a microsecond", "a nanosecond"}